@@ -0,0 +1,84 @@
+package python
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// queryAnnotations holds the directives that can be attached to a query by
+// adding comments of the form "-- @name value" above it.
+type queryAnnotations struct {
+	RowType    string
+	ParamsType string
+	// Params maps parameter names to the descriptions given with @param
+	Params map[string]string
+	// Many is how a :many query returns its rows, "list" or "iterator",
+	// overriding emit_many_as_list
+	Many string
+	// Paginate is the paging method emitted for a :many query, "keyset" or
+	// "offset", and PaginateKey the column of a keyset
+	Paginate    string
+	PaginateKey string
+	// ExecutionOptions are passed to SQLAlchemy when the query runs
+	ExecutionOptions []executionOption
+}
+
+var pyIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// parseQueryAnnotations extracts the known directives from the comments of a
+// query. All other comments, including unknown directives, are returned as-is.
+func parseQueryAnnotations(comments []string) (queryAnnotations, []string, error) {
+	var ann queryAnnotations
+	var rest []string
+	for _, comment := range comments {
+		line := strings.TrimSpace(comment)
+		if !strings.HasPrefix(line, "@") {
+			rest = append(rest, comment)
+			continue
+		}
+		name, value, _ := strings.Cut(line[1:], " ")
+		value = strings.TrimSpace(value)
+		switch name {
+		case "row_type":
+			if !pyIdentifierPattern.MatchString(value) || pyKeywords[value] {
+				return ann, nil, fmt.Errorf("@row_type: invalid class name %q", value)
+			}
+			ann.RowType = value
+		case "params_type":
+			if !pyIdentifierPattern.MatchString(value) || pyKeywords[value] {
+				return ann, nil, fmt.Errorf("@params_type: invalid class name %q", value)
+			}
+			ann.ParamsType = value
+		case "param":
+			param, desc, _ := strings.Cut(value, " ")
+			if !pyIdentifierPattern.MatchString(param) {
+				return ann, nil, fmt.Errorf("@param: invalid parameter name %q", param)
+			}
+			if ann.Params == nil {
+				ann.Params = map[string]string{}
+			}
+			ann.Params[param] = strings.TrimSpace(desc)
+		case "many":
+			if value != manyList && value != manyIterator {
+				return ann, nil, fmt.Errorf("@many: invalid value %q", value)
+			}
+			ann.Many = value
+		case "paginate":
+			mode, key, err := parsePaginate(value)
+			if err != nil {
+				return ann, nil, err
+			}
+			ann.Paginate, ann.PaginateKey = mode, key
+		case "execution_options":
+			opts, err := parseExecutionOptions(value)
+			if err != nil {
+				return ann, nil, err
+			}
+			ann.ExecutionOptions = opts
+		default:
+			rest = append(rest, comment)
+		}
+	}
+	return ann, rest, nil
+}
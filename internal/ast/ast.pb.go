@@ -57,6 +57,51 @@ type Node struct {
 	//	*Node_Await
 	//	*Node_AsyncFor
 	//	*Node_ImportGroup
+	//	*Node_List
+	//	*Node_Tuple
+	//	*Node_BinOp
+	//	*Node_BoolOp
+	//	*Node_UnaryOp
+	//	*Node_Lambda
+	//	*Node_IfExp
+	//	*Node_Starred
+	//	*Node_ListComp
+	//	*Node_Raise
+	//	*Node_Try
+	//	*Node_With
+	//	*Node_AsyncWith
+	//	*Node_Global
+	//	*Node_TypeAlias
+	//	*Node_Add
+	//	*Node_Sub
+	//	*Node_Mult
+	//	*Node_MatMult
+	//	*Node_Div
+	//	*Node_Mod
+	//	*Node_Pow
+	//	*Node_LShift
+	//	*Node_RShift
+	//	*Node_BitOr
+	//	*Node_BitXor
+	//	*Node_BitAnd
+	//	*Node_FloorDiv
+	//	*Node_And
+	//	*Node_Or
+	//	*Node_Invert
+	//	*Node_Not
+	//	*Node_UAdd
+	//	*Node_USub
+	//	*Node_Eq
+	//	*Node_NotEq
+	//	*Node_Lt
+	//	*Node_LtE
+	//	*Node_Gt
+	//	*Node_GtE
+	//	*Node_IsNot
+	//	*Node_In
+	//	*Node_NotIn
+	//	*Node_Break
+	//	*Node_Continue
 	Node isNode_Node `protobuf_oneof:"node"`
 }
 
@@ -309,6 +354,321 @@ func (x *Node) GetImportGroup() *ImportGroup {
 	return nil
 }
 
+func (x *Node) GetList() *List {
+	if x, ok := x.GetNode().(*Node_List); ok {
+		return x.List
+	}
+	return nil
+}
+
+func (x *Node) GetTuple() *Tuple {
+	if x, ok := x.GetNode().(*Node_Tuple); ok {
+		return x.Tuple
+	}
+	return nil
+}
+
+func (x *Node) GetBinOp() *BinOp {
+	if x, ok := x.GetNode().(*Node_BinOp); ok {
+		return x.BinOp
+	}
+	return nil
+}
+
+func (x *Node) GetBoolOp() *BoolOp {
+	if x, ok := x.GetNode().(*Node_BoolOp); ok {
+		return x.BoolOp
+	}
+	return nil
+}
+
+func (x *Node) GetUnaryOp() *UnaryOp {
+	if x, ok := x.GetNode().(*Node_UnaryOp); ok {
+		return x.UnaryOp
+	}
+	return nil
+}
+
+func (x *Node) GetLambda() *Lambda {
+	if x, ok := x.GetNode().(*Node_Lambda); ok {
+		return x.Lambda
+	}
+	return nil
+}
+
+func (x *Node) GetIfExp() *IfExp {
+	if x, ok := x.GetNode().(*Node_IfExp); ok {
+		return x.IfExp
+	}
+	return nil
+}
+
+func (x *Node) GetStarred() *Starred {
+	if x, ok := x.GetNode().(*Node_Starred); ok {
+		return x.Starred
+	}
+	return nil
+}
+
+func (x *Node) GetListComp() *ListComp {
+	if x, ok := x.GetNode().(*Node_ListComp); ok {
+		return x.ListComp
+	}
+	return nil
+}
+
+func (x *Node) GetRaise() *Raise {
+	if x, ok := x.GetNode().(*Node_Raise); ok {
+		return x.Raise
+	}
+	return nil
+}
+
+func (x *Node) GetTry() *Try {
+	if x, ok := x.GetNode().(*Node_Try); ok {
+		return x.Try
+	}
+	return nil
+}
+
+func (x *Node) GetWith() *With {
+	if x, ok := x.GetNode().(*Node_With); ok {
+		return x.With
+	}
+	return nil
+}
+
+func (x *Node) GetAsyncWith() *AsyncWith {
+	if x, ok := x.GetNode().(*Node_AsyncWith); ok {
+		return x.AsyncWith
+	}
+	return nil
+}
+
+func (x *Node) GetGlobal() *Global {
+	if x, ok := x.GetNode().(*Node_Global); ok {
+		return x.Global
+	}
+	return nil
+}
+
+func (x *Node) GetTypeAlias() *TypeAlias {
+	if x, ok := x.GetNode().(*Node_TypeAlias); ok {
+		return x.TypeAlias
+	}
+	return nil
+}
+
+func (x *Node) GetAdd() *Add {
+	if x, ok := x.GetNode().(*Node_Add); ok {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *Node) GetSub() *Sub {
+	if x, ok := x.GetNode().(*Node_Sub); ok {
+		return x.Sub
+	}
+	return nil
+}
+
+func (x *Node) GetMult() *Mult {
+	if x, ok := x.GetNode().(*Node_Mult); ok {
+		return x.Mult
+	}
+	return nil
+}
+
+func (x *Node) GetMatMult() *MatMult {
+	if x, ok := x.GetNode().(*Node_MatMult); ok {
+		return x.MatMult
+	}
+	return nil
+}
+
+func (x *Node) GetDiv() *Div {
+	if x, ok := x.GetNode().(*Node_Div); ok {
+		return x.Div
+	}
+	return nil
+}
+
+func (x *Node) GetMod() *Mod {
+	if x, ok := x.GetNode().(*Node_Mod); ok {
+		return x.Mod
+	}
+	return nil
+}
+
+func (x *Node) GetPow() *Pow {
+	if x, ok := x.GetNode().(*Node_Pow); ok {
+		return x.Pow
+	}
+	return nil
+}
+
+func (x *Node) GetLShift() *LShift {
+	if x, ok := x.GetNode().(*Node_LShift); ok {
+		return x.LShift
+	}
+	return nil
+}
+
+func (x *Node) GetRShift() *RShift {
+	if x, ok := x.GetNode().(*Node_RShift); ok {
+		return x.RShift
+	}
+	return nil
+}
+
+func (x *Node) GetBitOr() *BitOr {
+	if x, ok := x.GetNode().(*Node_BitOr); ok {
+		return x.BitOr
+	}
+	return nil
+}
+
+func (x *Node) GetBitXor() *BitXor {
+	if x, ok := x.GetNode().(*Node_BitXor); ok {
+		return x.BitXor
+	}
+	return nil
+}
+
+func (x *Node) GetBitAnd() *BitAnd {
+	if x, ok := x.GetNode().(*Node_BitAnd); ok {
+		return x.BitAnd
+	}
+	return nil
+}
+
+func (x *Node) GetFloorDiv() *FloorDiv {
+	if x, ok := x.GetNode().(*Node_FloorDiv); ok {
+		return x.FloorDiv
+	}
+	return nil
+}
+
+func (x *Node) GetAnd() *And {
+	if x, ok := x.GetNode().(*Node_And); ok {
+		return x.And
+	}
+	return nil
+}
+
+func (x *Node) GetOr() *Or {
+	if x, ok := x.GetNode().(*Node_Or); ok {
+		return x.Or
+	}
+	return nil
+}
+
+func (x *Node) GetInvert() *Invert {
+	if x, ok := x.GetNode().(*Node_Invert); ok {
+		return x.Invert
+	}
+	return nil
+}
+
+func (x *Node) GetNot() *Not {
+	if x, ok := x.GetNode().(*Node_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+func (x *Node) GetUAdd() *UAdd {
+	if x, ok := x.GetNode().(*Node_UAdd); ok {
+		return x.UAdd
+	}
+	return nil
+}
+
+func (x *Node) GetUSub() *USub {
+	if x, ok := x.GetNode().(*Node_USub); ok {
+		return x.USub
+	}
+	return nil
+}
+
+func (x *Node) GetEq() *Eq {
+	if x, ok := x.GetNode().(*Node_Eq); ok {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *Node) GetNotEq() *NotEq {
+	if x, ok := x.GetNode().(*Node_NotEq); ok {
+		return x.NotEq
+	}
+	return nil
+}
+
+func (x *Node) GetLt() *Lt {
+	if x, ok := x.GetNode().(*Node_Lt); ok {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *Node) GetLtE() *LtE {
+	if x, ok := x.GetNode().(*Node_LtE); ok {
+		return x.LtE
+	}
+	return nil
+}
+
+func (x *Node) GetGt() *Gt {
+	if x, ok := x.GetNode().(*Node_Gt); ok {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *Node) GetGtE() *GtE {
+	if x, ok := x.GetNode().(*Node_GtE); ok {
+		return x.GtE
+	}
+	return nil
+}
+
+func (x *Node) GetIsNot() *IsNot {
+	if x, ok := x.GetNode().(*Node_IsNot); ok {
+		return x.IsNot
+	}
+	return nil
+}
+
+func (x *Node) GetIn() *In {
+	if x, ok := x.GetNode().(*Node_In); ok {
+		return x.In
+	}
+	return nil
+}
+
+func (x *Node) GetNotIn() *NotIn {
+	if x, ok := x.GetNode().(*Node_NotIn); ok {
+		return x.NotIn
+	}
+	return nil
+}
+
+func (x *Node) GetBreak() *Break {
+	if x, ok := x.GetNode().(*Node_Break); ok {
+		return x.Break
+	}
+	return nil
+}
+
+func (x *Node) GetContinue() *Continue {
+	if x, ok := x.GetNode().(*Node_Continue); ok {
+		return x.Continue
+	}
+	return nil
+}
+
 type isNode_Node interface {
 	isNode_Node()
 }
@@ -433,88 +793,359 @@ type Node_ImportGroup struct {
 	ImportGroup *ImportGroup `protobuf:"bytes,30,opt,name=import_group,json=ImportGroup,proto3,oneof"`
 }
 
-func (*Node_ClassDef) isNode_Node() {}
+type Node_List struct {
+	List *List `protobuf:"bytes,31,opt,name=list,json=List,proto3,oneof"`
+}
 
-func (*Node_Import) isNode_Node() {}
+type Node_Tuple struct {
+	Tuple *Tuple `protobuf:"bytes,32,opt,name=tuple,json=Tuple,proto3,oneof"`
+}
 
-func (*Node_ImportFrom) isNode_Node() {}
+type Node_BinOp struct {
+	BinOp *BinOp `protobuf:"bytes,33,opt,name=bin_op,json=BinOp,proto3,oneof"`
+}
 
-func (*Node_Module) isNode_Node() {}
+type Node_BoolOp struct {
+	BoolOp *BoolOp `protobuf:"bytes,34,opt,name=bool_op,json=BoolOp,proto3,oneof"`
+}
 
-func (*Node_Alias) isNode_Node() {}
+type Node_UnaryOp struct {
+	UnaryOp *UnaryOp `protobuf:"bytes,35,opt,name=unary_op,json=UnaryOp,proto3,oneof"`
+}
 
-func (*Node_AnnAssign) isNode_Node() {}
+type Node_Lambda struct {
+	Lambda *Lambda `protobuf:"bytes,36,opt,name=lambda,json=Lambda,proto3,oneof"`
+}
 
-func (*Node_Name) isNode_Node() {}
+type Node_IfExp struct {
+	IfExp *IfExp `protobuf:"bytes,37,opt,name=if_exp,json=IfExp,proto3,oneof"`
+}
 
-func (*Node_Subscript) isNode_Node() {}
+type Node_Starred struct {
+	Starred *Starred `protobuf:"bytes,38,opt,name=starred,json=Starred,proto3,oneof"`
+}
 
-func (*Node_Attribute) isNode_Node() {}
+type Node_ListComp struct {
+	ListComp *ListComp `protobuf:"bytes,39,opt,name=list_comp,json=ListComp,proto3,oneof"`
+}
 
-func (*Node_Constant) isNode_Node() {}
+type Node_Raise struct {
+	Raise *Raise `protobuf:"bytes,40,opt,name=raise,json=Raise,proto3,oneof"`
+}
 
-func (*Node_Assign) isNode_Node() {}
+type Node_Try struct {
+	Try *Try `protobuf:"bytes,41,opt,name=try,json=Try,proto3,oneof"`
+}
 
-func (*Node_Comment) isNode_Node() {}
+type Node_With struct {
+	With *With `protobuf:"bytes,42,opt,name=with,json=With,proto3,oneof"`
+}
 
-func (*Node_Expr) isNode_Node() {}
+type Node_AsyncWith struct {
+	AsyncWith *AsyncWith `protobuf:"bytes,43,opt,name=async_with,json=AsyncWith,proto3,oneof"`
+}
 
-func (*Node_Call) isNode_Node() {}
+type Node_Global struct {
+	Global *Global `protobuf:"bytes,44,opt,name=global,json=Global,proto3,oneof"`
+}
 
-func (*Node_FunctionDef) isNode_Node() {}
+type Node_TypeAlias struct {
+	TypeAlias *TypeAlias `protobuf:"bytes,45,opt,name=type_alias,json=TypeAlias,proto3,oneof"`
+}
 
-func (*Node_Arg) isNode_Node() {}
+type Node_Add struct {
+	Add *Add `protobuf:"bytes,46,opt,name=add,json=Add,proto3,oneof"`
+}
 
-func (*Node_Arguments) isNode_Node() {}
+type Node_Sub struct {
+	Sub *Sub `protobuf:"bytes,47,opt,name=sub,json=Sub,proto3,oneof"`
+}
 
-func (*Node_AsyncFunctionDef) isNode_Node() {}
+type Node_Mult struct {
+	Mult *Mult `protobuf:"bytes,48,opt,name=mult,json=Mult,proto3,oneof"`
+}
 
-func (*Node_Pass) isNode_Node() {}
+type Node_MatMult struct {
+	MatMult *MatMult `protobuf:"bytes,49,opt,name=mat_mult,json=MatMult,proto3,oneof"`
+}
 
-func (*Node_Dict) isNode_Node() {}
+type Node_Div struct {
+	Div *Div `protobuf:"bytes,50,opt,name=div,json=Div,proto3,oneof"`
+}
 
-func (*Node_If) isNode_Node() {}
+type Node_Mod struct {
+	Mod *Mod `protobuf:"bytes,51,opt,name=mod,json=Mod,proto3,oneof"`
+}
 
-func (*Node_Compare) isNode_Node() {}
+type Node_Pow struct {
+	Pow *Pow `protobuf:"bytes,52,opt,name=pow,json=Pow,proto3,oneof"`
+}
 
-func (*Node_Return) isNode_Node() {}
+type Node_LShift struct {
+	LShift *LShift `protobuf:"bytes,53,opt,name=l_shift,json=LShift,proto3,oneof"`
+}
 
-func (*Node_Is) isNode_Node() {}
+type Node_RShift struct {
+	RShift *RShift `protobuf:"bytes,54,opt,name=r_shift,json=RShift,proto3,oneof"`
+}
 
-func (*Node_Keyword) isNode_Node() {}
+type Node_BitOr struct {
+	BitOr *BitOr `protobuf:"bytes,55,opt,name=bit_or,json=BitOr,proto3,oneof"`
+}
 
-func (*Node_Yield) isNode_Node() {}
+type Node_BitXor struct {
+	BitXor *BitXor `protobuf:"bytes,56,opt,name=bit_xor,json=BitXor,proto3,oneof"`
+}
 
-func (*Node_For) isNode_Node() {}
+type Node_BitAnd struct {
+	BitAnd *BitAnd `protobuf:"bytes,57,opt,name=bit_and,json=BitAnd,proto3,oneof"`
+}
 
-func (*Node_Await) isNode_Node() {}
+type Node_FloorDiv struct {
+	FloorDiv *FloorDiv `protobuf:"bytes,58,opt,name=floor_div,json=FloorDiv,proto3,oneof"`
+}
 
-func (*Node_AsyncFor) isNode_Node() {}
+type Node_And struct {
+	And *And `protobuf:"bytes,59,opt,name=and,json=And,proto3,oneof"`
+}
 
-func (*Node_ImportGroup) isNode_Node() {}
+type Node_Or struct {
+	Or *Or `protobuf:"bytes,60,opt,name=or,json=Or,proto3,oneof"`
+}
 
-type Alias struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Node_Invert struct {
+	Invert *Invert `protobuf:"bytes,61,opt,name=invert,json=Invert,proto3,oneof"`
+}
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+type Node_Not struct {
+	Not *Not `protobuf:"bytes,62,opt,name=not,json=Not,proto3,oneof"`
 }
 
-func (x *Alias) Reset() {
-	*x = Alias{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Node_UAdd struct {
+	UAdd *UAdd `protobuf:"bytes,63,opt,name=u_add,json=UAdd,proto3,oneof"`
 }
 
-func (x *Alias) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Node_USub struct {
+	USub *USub `protobuf:"bytes,64,opt,name=u_sub,json=USub,proto3,oneof"`
 }
 
-func (*Alias) ProtoMessage() {}
+type Node_Eq struct {
+	Eq *Eq `protobuf:"bytes,65,opt,name=eq,json=Eq,proto3,oneof"`
+}
+
+type Node_NotEq struct {
+	NotEq *NotEq `protobuf:"bytes,66,opt,name=not_eq,json=NotEq,proto3,oneof"`
+}
+
+type Node_Lt struct {
+	Lt *Lt `protobuf:"bytes,67,opt,name=lt,json=Lt,proto3,oneof"`
+}
+
+type Node_LtE struct {
+	LtE *LtE `protobuf:"bytes,68,opt,name=lt_e,json=LtE,proto3,oneof"`
+}
+
+type Node_Gt struct {
+	Gt *Gt `protobuf:"bytes,69,opt,name=gt,json=Gt,proto3,oneof"`
+}
+
+type Node_GtE struct {
+	GtE *GtE `protobuf:"bytes,70,opt,name=gt_e,json=GtE,proto3,oneof"`
+}
+
+type Node_IsNot struct {
+	IsNot *IsNot `protobuf:"bytes,71,opt,name=is_not,json=IsNot,proto3,oneof"`
+}
+
+type Node_In struct {
+	In *In `protobuf:"bytes,72,opt,name=in,json=In,proto3,oneof"`
+}
+
+type Node_NotIn struct {
+	NotIn *NotIn `protobuf:"bytes,73,opt,name=not_in,json=NotIn,proto3,oneof"`
+}
+
+type Node_Break struct {
+	Break *Break `protobuf:"bytes,74,opt,name=break,json=Break,proto3,oneof"`
+}
+
+type Node_Continue struct {
+	Continue *Continue `protobuf:"bytes,75,opt,name=continue,json=Continue,proto3,oneof"`
+}
+
+func (*Node_ClassDef) isNode_Node() {}
+
+func (*Node_Import) isNode_Node() {}
+
+func (*Node_ImportFrom) isNode_Node() {}
+
+func (*Node_Module) isNode_Node() {}
+
+func (*Node_Alias) isNode_Node() {}
+
+func (*Node_AnnAssign) isNode_Node() {}
+
+func (*Node_Name) isNode_Node() {}
+
+func (*Node_Subscript) isNode_Node() {}
+
+func (*Node_Attribute) isNode_Node() {}
+
+func (*Node_Constant) isNode_Node() {}
+
+func (*Node_Assign) isNode_Node() {}
+
+func (*Node_Comment) isNode_Node() {}
+
+func (*Node_Expr) isNode_Node() {}
+
+func (*Node_Call) isNode_Node() {}
+
+func (*Node_FunctionDef) isNode_Node() {}
+
+func (*Node_Arg) isNode_Node() {}
+
+func (*Node_Arguments) isNode_Node() {}
+
+func (*Node_AsyncFunctionDef) isNode_Node() {}
+
+func (*Node_Pass) isNode_Node() {}
+
+func (*Node_Dict) isNode_Node() {}
+
+func (*Node_If) isNode_Node() {}
+
+func (*Node_Compare) isNode_Node() {}
+
+func (*Node_Return) isNode_Node() {}
+
+func (*Node_Is) isNode_Node() {}
+
+func (*Node_Keyword) isNode_Node() {}
+
+func (*Node_Yield) isNode_Node() {}
+
+func (*Node_For) isNode_Node() {}
+
+func (*Node_Await) isNode_Node() {}
+
+func (*Node_AsyncFor) isNode_Node() {}
+
+func (*Node_ImportGroup) isNode_Node() {}
+
+func (*Node_List) isNode_Node() {}
+
+func (*Node_Tuple) isNode_Node() {}
+
+func (*Node_BinOp) isNode_Node() {}
+
+func (*Node_BoolOp) isNode_Node() {}
+
+func (*Node_UnaryOp) isNode_Node() {}
+
+func (*Node_Lambda) isNode_Node() {}
+
+func (*Node_IfExp) isNode_Node() {}
+
+func (*Node_Starred) isNode_Node() {}
+
+func (*Node_ListComp) isNode_Node() {}
+
+func (*Node_Raise) isNode_Node() {}
+
+func (*Node_Try) isNode_Node() {}
+
+func (*Node_With) isNode_Node() {}
+
+func (*Node_AsyncWith) isNode_Node() {}
+
+func (*Node_Global) isNode_Node() {}
+
+func (*Node_TypeAlias) isNode_Node() {}
+
+func (*Node_Add) isNode_Node() {}
+
+func (*Node_Sub) isNode_Node() {}
+
+func (*Node_Mult) isNode_Node() {}
+
+func (*Node_MatMult) isNode_Node() {}
+
+func (*Node_Div) isNode_Node() {}
+
+func (*Node_Mod) isNode_Node() {}
+
+func (*Node_Pow) isNode_Node() {}
+
+func (*Node_LShift) isNode_Node() {}
+
+func (*Node_RShift) isNode_Node() {}
+
+func (*Node_BitOr) isNode_Node() {}
+
+func (*Node_BitXor) isNode_Node() {}
+
+func (*Node_BitAnd) isNode_Node() {}
+
+func (*Node_FloorDiv) isNode_Node() {}
+
+func (*Node_And) isNode_Node() {}
+
+func (*Node_Or) isNode_Node() {}
+
+func (*Node_Invert) isNode_Node() {}
+
+func (*Node_Not) isNode_Node() {}
+
+func (*Node_UAdd) isNode_Node() {}
+
+func (*Node_USub) isNode_Node() {}
+
+func (*Node_Eq) isNode_Node() {}
+
+func (*Node_NotEq) isNode_Node() {}
+
+func (*Node_Lt) isNode_Node() {}
+
+func (*Node_LtE) isNode_Node() {}
+
+func (*Node_Gt) isNode_Node() {}
+
+func (*Node_GtE) isNode_Node() {}
+
+func (*Node_IsNot) isNode_Node() {}
+
+func (*Node_In) isNode_Node() {}
+
+func (*Node_NotIn) isNode_Node() {}
+
+func (*Node_Break) isNode_Node() {}
+
+func (*Node_Continue) isNode_Node() {}
+
+type Alias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Asname string `protobuf:"bytes,2,opt,name=asname,proto3" json:"asname,omitempty"`
+}
+
+func (x *Alias) Reset() {
+	*x = Alias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alias) ProtoMessage() {}
 
 func (x *Alias) ProtoReflect() protoreflect.Message {
 	mi := &file_ast_ast_proto_msgTypes[1]
@@ -540,6 +1171,89 @@ func (x *Alias) GetName() string {
 	return ""
 }
 
+func (x *Alias) GetAsname() string {
+	if x != nil {
+		return x.Asname
+	}
+	return ""
+}
+
+type Add struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Add) Reset() {
+	*x = Add{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Add) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Add) ProtoMessage() {}
+
+func (x *Add) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Add.ProtoReflect.Descriptor instead.
+func (*Add) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{2}
+}
+
+type And struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *And) Reset() {
+	*x = And{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *And) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*And) ProtoMessage() {}
+
+func (x *And) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use And.ProtoReflect.Descriptor instead.
+func (*And) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{3}
+}
+
 type Await struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -551,7 +1265,7 @@ type Await struct {
 func (x *Await) Reset() {
 	*x = Await{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[2]
+		mi := &file_ast_ast_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -564,7 +1278,7 @@ func (x *Await) String() string {
 func (*Await) ProtoMessage() {}
 
 func (x *Await) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[2]
+	mi := &file_ast_ast_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +1291,7 @@ func (x *Await) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Await.ProtoReflect.Descriptor instead.
 func (*Await) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{2}
+	return file_ast_ast_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Await) GetValue() *Node {
@@ -599,7 +1313,7 @@ type Attribute struct {
 func (x *Attribute) Reset() {
 	*x = Attribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[3]
+		mi := &file_ast_ast_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -612,7 +1326,7 @@ func (x *Attribute) String() string {
 func (*Attribute) ProtoMessage() {}
 
 func (x *Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[3]
+	mi := &file_ast_ast_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +1339,7 @@ func (x *Attribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{3}
+	return file_ast_ast_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Attribute) GetValue() *Node {
@@ -656,7 +1370,7 @@ type AnnAssign struct {
 func (x *AnnAssign) Reset() {
 	*x = AnnAssign{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[4]
+		mi := &file_ast_ast_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -669,7 +1383,7 @@ func (x *AnnAssign) String() string {
 func (*AnnAssign) ProtoMessage() {}
 
 func (x *AnnAssign) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[4]
+	mi := &file_ast_ast_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +1396,7 @@ func (x *AnnAssign) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnnAssign.ProtoReflect.Descriptor instead.
 func (*AnnAssign) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{4}
+	return file_ast_ast_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AnnAssign) GetTarget() *Name {
@@ -725,7 +1439,7 @@ type Arg struct {
 func (x *Arg) Reset() {
 	*x = Arg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[5]
+		mi := &file_ast_ast_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -738,7 +1452,7 @@ func (x *Arg) String() string {
 func (*Arg) ProtoMessage() {}
 
 func (x *Arg) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[5]
+	mi := &file_ast_ast_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +1465,7 @@ func (x *Arg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Arg.ProtoReflect.Descriptor instead.
 func (*Arg) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{5}
+	return file_ast_ast_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Arg) GetArg() string {
@@ -773,14 +1487,22 @@ type Arguments struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Args       []*Arg `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
-	KwOnlyArgs []*Arg `protobuf:"bytes,2,rep,name=kw_only_args,json=kwonlyargs,proto3" json:"kw_only_args,omitempty"`
+	Args        []*Arg `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	KwOnlyArgs  []*Arg `protobuf:"bytes,2,rep,name=kw_only_args,json=kwonlyargs,proto3" json:"kw_only_args,omitempty"`
+	PosOnlyArgs []*Arg `protobuf:"bytes,3,rep,name=pos_only_args,json=posonlyargs,proto3" json:"pos_only_args,omitempty"`
+	Vararg      *Arg   `protobuf:"bytes,4,opt,name=vararg,proto3" json:"vararg,omitempty"`
+	Kwarg       *Arg   `protobuf:"bytes,5,opt,name=kwarg,proto3" json:"kwarg,omitempty"`
+	// Default values of the last positional arguments
+	Defaults []*Node `protobuf:"bytes,6,rep,name=defaults,proto3" json:"defaults,omitempty"`
+	// Default values of the keyword-only arguments. An empty node means the
+	// argument has no default value.
+	KwDefaults []*Node `protobuf:"bytes,7,rep,name=kw_defaults,proto3" json:"kw_defaults,omitempty"`
 }
 
 func (x *Arguments) Reset() {
 	*x = Arguments{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[6]
+		mi := &file_ast_ast_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -793,7 +1515,7 @@ func (x *Arguments) String() string {
 func (*Arguments) ProtoMessage() {}
 
 func (x *Arguments) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[6]
+	mi := &file_ast_ast_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +1528,7 @@ func (x *Arguments) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Arguments.ProtoReflect.Descriptor instead.
 func (*Arguments) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{6}
+	return file_ast_ast_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Arguments) GetArgs() []*Arg {
@@ -823,20 +1545,55 @@ func (x *Arguments) GetKwOnlyArgs() []*Arg {
 	return nil
 }
 
-type AsyncFor struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Arguments) GetPosOnlyArgs() []*Arg {
+	if x != nil {
+		return x.PosOnlyArgs
+	}
+	return nil
+}
 
-	Target *Node   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	Iter   *Node   `protobuf:"bytes,2,opt,name=iter,proto3" json:"iter,omitempty"`
-	Body   []*Node `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
+func (x *Arguments) GetVararg() *Arg {
+	if x != nil {
+		return x.Vararg
+	}
+	return nil
+}
+
+func (x *Arguments) GetKwarg() *Arg {
+	if x != nil {
+		return x.Kwarg
+	}
+	return nil
+}
+
+func (x *Arguments) GetDefaults() []*Node {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *Arguments) GetKwDefaults() []*Node {
+	if x != nil {
+		return x.KwDefaults
+	}
+	return nil
+}
+
+type AsyncFor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target *Node   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Iter   *Node   `protobuf:"bytes,2,opt,name=iter,proto3" json:"iter,omitempty"`
+	Body   []*Node `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
 }
 
 func (x *AsyncFor) Reset() {
 	*x = AsyncFor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[7]
+		mi := &file_ast_ast_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -849,7 +1606,7 @@ func (x *AsyncFor) String() string {
 func (*AsyncFor) ProtoMessage() {}
 
 func (x *AsyncFor) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[7]
+	mi := &file_ast_ast_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,7 +1619,7 @@ func (x *AsyncFor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AsyncFor.ProtoReflect.Descriptor instead.
 func (*AsyncFor) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{7}
+	return file_ast_ast_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AsyncFor) GetTarget() *Node {
@@ -891,16 +1648,17 @@ type AsyncFunctionDef struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Args    *Arguments `protobuf:"bytes,2,opt,name=Args,json=args,proto3" json:"Args,omitempty"`
-	Body    []*Node    `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
-	Returns *Node      `protobuf:"bytes,4,opt,name=returns,proto3" json:"returns,omitempty"`
+	Name          string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args          *Arguments `protobuf:"bytes,2,opt,name=Args,json=args,proto3" json:"Args,omitempty"`
+	Body          []*Node    `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
+	Returns       *Node      `protobuf:"bytes,4,opt,name=returns,proto3" json:"returns,omitempty"`
+	DecoratorList []*Node    `protobuf:"bytes,5,rep,name=decorator_list,proto3" json:"decorator_list,omitempty"`
 }
 
 func (x *AsyncFunctionDef) Reset() {
 	*x = AsyncFunctionDef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[8]
+		mi := &file_ast_ast_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -913,7 +1671,7 @@ func (x *AsyncFunctionDef) String() string {
 func (*AsyncFunctionDef) ProtoMessage() {}
 
 func (x *AsyncFunctionDef) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[8]
+	mi := &file_ast_ast_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1684,7 @@ func (x *AsyncFunctionDef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AsyncFunctionDef.ProtoReflect.Descriptor instead.
 func (*AsyncFunctionDef) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{8}
+	return file_ast_ast_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AsyncFunctionDef) GetName() string {
@@ -957,6 +1715,68 @@ func (x *AsyncFunctionDef) GetReturns() *Node {
 	return nil
 }
 
+func (x *AsyncFunctionDef) GetDecoratorList() []*Node {
+	if x != nil {
+		return x.DecoratorList
+	}
+	return nil
+}
+
+type AsyncWith struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WithItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Body  []*Node     `protobuf:"bytes,2,rep,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *AsyncWith) Reset() {
+	*x = AsyncWith{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncWith) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncWith) ProtoMessage() {}
+
+func (x *AsyncWith) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncWith.ProtoReflect.Descriptor instead.
+func (*AsyncWith) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AsyncWith) GetItems() []*WithItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AsyncWith) GetBody() []*Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
 type Assign struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -970,7 +1790,7 @@ type Assign struct {
 func (x *Assign) Reset() {
 	*x = Assign{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[9]
+		mi := &file_ast_ast_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -983,7 +1803,7 @@ func (x *Assign) String() string {
 func (*Assign) ProtoMessage() {}
 
 func (x *Assign) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[9]
+	mi := &file_ast_ast_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1816,7 @@ func (x *Assign) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Assign.ProtoReflect.Descriptor instead.
 func (*Assign) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{9}
+	return file_ast_ast_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Assign) GetTargets() []*Node {
@@ -1020,33 +1840,33 @@ func (x *Assign) GetComment() string {
 	return ""
 }
 
-type Call struct {
+type BinOp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Func     *Node      `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
-	Args     []*Node    `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	Keywords []*Keyword `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Left  *Node `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Op    *Node `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Right *Node `protobuf:"bytes,3,opt,name=right,proto3" json:"right,omitempty"`
 }
 
-func (x *Call) Reset() {
-	*x = Call{}
+func (x *BinOp) Reset() {
+	*x = BinOp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[10]
+		mi := &file_ast_ast_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Call) String() string {
+func (x *BinOp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Call) ProtoMessage() {}
+func (*BinOp) ProtoMessage() {}
 
-func (x *Call) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[10]
+func (x *BinOp) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,61 +1877,55 @@ func (x *Call) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Call.ProtoReflect.Descriptor instead.
-func (*Call) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BinOp.ProtoReflect.Descriptor instead.
+func (*BinOp) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Call) GetFunc() *Node {
+func (x *BinOp) GetLeft() *Node {
 	if x != nil {
-		return x.Func
+		return x.Left
 	}
 	return nil
 }
 
-func (x *Call) GetArgs() []*Node {
+func (x *BinOp) GetOp() *Node {
 	if x != nil {
-		return x.Args
+		return x.Op
 	}
 	return nil
 }
 
-func (x *Call) GetKeywords() []*Keyword {
+func (x *BinOp) GetRight() *Node {
 	if x != nil {
-		return x.Keywords
+		return x.Right
 	}
 	return nil
 }
 
-type ClassDef struct {
+type BitAnd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name          string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Bases         []*Node `protobuf:"bytes,2,rep,name=bases,proto3" json:"bases,omitempty"`
-	Keywords      []*Node `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`
-	Body          []*Node `protobuf:"bytes,4,rep,name=body,proto3" json:"body,omitempty"`
-	DecoratorList []*Node `protobuf:"bytes,5,rep,name=decorator_list,proto3" json:"decorator_list,omitempty"`
 }
 
-func (x *ClassDef) Reset() {
-	*x = ClassDef{}
+func (x *BitAnd) Reset() {
+	*x = BitAnd{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[11]
+		mi := &file_ast_ast_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClassDef) String() string {
+func (x *BitAnd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassDef) ProtoMessage() {}
+func (*BitAnd) ProtoMessage() {}
 
-func (x *ClassDef) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[11]
+func (x *BitAnd) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,73 +1936,72 @@ func (x *ClassDef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassDef.ProtoReflect.Descriptor instead.
-func (*ClassDef) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use BitAnd.ProtoReflect.Descriptor instead.
+func (*BitAnd) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ClassDef) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type BitOr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ClassDef) GetBases() []*Node {
-	if x != nil {
-		return x.Bases
+func (x *BitOr) Reset() {
+	*x = BitOr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ClassDef) GetKeywords() []*Node {
-	if x != nil {
-		return x.Keywords
-	}
-	return nil
+func (x *BitOr) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ClassDef) GetBody() []*Node {
-	if x != nil {
-		return x.Body
+func (*BitOr) ProtoMessage() {}
+
+func (x *BitOr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ClassDef) GetDecoratorList() []*Node {
-	if x != nil {
-		return x.DecoratorList
-	}
-	return nil
+// Deprecated: Use BitOr.ProtoReflect.Descriptor instead.
+func (*BitOr) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{15}
 }
 
-// The Python ast module does not parse comments. It's not clear if this is the
-// best way to support them in the AST
-type Comment struct {
+type BitXor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
+func (x *BitXor) Reset() {
+	*x = BitXor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[12]
+		mi := &file_ast_ast_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Comment) String() string {
+func (x *BitXor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*BitXor) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[12]
+func (x *BitXor) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,45 +2012,37 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *Comment) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
+// Deprecated: Use BitXor.ProtoReflect.Descriptor instead.
+func (*BitXor) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{16}
 }
 
-type Compare struct {
+type BoolOp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Left        *Node   `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
-	Ops         []*Node `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
-	Comparators []*Node `protobuf:"bytes,3,rep,name=comparators,proto3" json:"comparators,omitempty"`
+	Op     *Node   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Values []*Node `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *Compare) Reset() {
-	*x = Compare{}
+func (x *BoolOp) Reset() {
+	*x = BoolOp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[13]
+		mi := &file_ast_ast_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Compare) String() string {
+func (x *BoolOp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Compare) ProtoMessage() {}
+func (*BoolOp) ProtoMessage() {}
 
-func (x *Compare) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[13]
+func (x *BoolOp) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,62 +2053,48 @@ func (x *Compare) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Compare.ProtoReflect.Descriptor instead.
-func (*Compare) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *Compare) GetLeft() *Node {
-	if x != nil {
-		return x.Left
-	}
-	return nil
+// Deprecated: Use BoolOp.ProtoReflect.Descriptor instead.
+func (*BoolOp) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Compare) GetOps() []*Node {
+func (x *BoolOp) GetOp() *Node {
 	if x != nil {
-		return x.Ops
+		return x.Op
 	}
 	return nil
 }
 
-func (x *Compare) GetComparators() []*Node {
+func (x *BoolOp) GetValues() []*Node {
 	if x != nil {
-		return x.Comparators
+		return x.Values
 	}
 	return nil
 }
 
-type Constant struct {
+type Break struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Value:
-	//
-	//	*Constant_Str
-	//	*Constant_Int
-	//	*Constant_None
-	Value isConstant_Value `protobuf_oneof:"value"`
 }
 
-func (x *Constant) Reset() {
-	*x = Constant{}
+func (x *Break) Reset() {
+	*x = Break{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[14]
+		mi := &file_ast_ast_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Constant) String() string {
+func (x *Break) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Constant) ProtoMessage() {}
+func (*Break) ProtoMessage() {}
 
-func (x *Constant) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[14]
+func (x *Break) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,87 +2105,103 @@ func (x *Constant) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Constant.ProtoReflect.Descriptor instead.
-func (*Constant) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Break.ProtoReflect.Descriptor instead.
+func (*Break) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{18}
 }
 
-func (m *Constant) GetValue() isConstant_Value {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+type Call struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Func     *Node      `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
+	Args     []*Node    `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Keywords []*Keyword `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`
 }
 
-func (x *Constant) GetStr() string {
-	if x, ok := x.GetValue().(*Constant_Str); ok {
-		return x.Str
+func (x *Call) Reset() {
+	*x = Call{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Constant) GetInt() int32 {
-	if x, ok := x.GetValue().(*Constant_Int); ok {
-		return x.Int
-	}
-	return 0
+func (x *Call) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Constant) GetNone() bool {
-	if x, ok := x.GetValue().(*Constant_None); ok {
-		return x.None
+func (*Call) ProtoMessage() {}
+
+func (x *Call) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type isConstant_Value interface {
-	isConstant_Value()
+// Deprecated: Use Call.ProtoReflect.Descriptor instead.
+func (*Call) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{19}
 }
 
-type Constant_Str struct {
-	Str string `protobuf:"bytes,1,opt,name=str,json=string,proto3,oneof"`
+func (x *Call) GetFunc() *Node {
+	if x != nil {
+		return x.Func
+	}
+	return nil
 }
 
-type Constant_Int struct {
-	Int int32 `protobuf:"varint,2,opt,name=int,proto3,oneof"`
+func (x *Call) GetArgs() []*Node {
+	if x != nil {
+		return x.Args
+	}
+	return nil
 }
 
-type Constant_None struct {
-	None bool `protobuf:"varint,3,opt,name=none,proto3,oneof"`
+func (x *Call) GetKeywords() []*Keyword {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
 }
 
-func (*Constant_Str) isConstant_Value() {}
-
-func (*Constant_Int) isConstant_Value() {}
-
-func (*Constant_None) isConstant_Value() {}
-
-type Dict struct {
+type ClassDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keys   []*Node `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	Values []*Node `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Name          string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bases         []*Node `protobuf:"bytes,2,rep,name=bases,proto3" json:"bases,omitempty"`
+	Keywords      []*Node `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Body          []*Node `protobuf:"bytes,4,rep,name=body,proto3" json:"body,omitempty"`
+	DecoratorList []*Node `protobuf:"bytes,5,rep,name=decorator_list,proto3" json:"decorator_list,omitempty"`
 }
 
-func (x *Dict) Reset() {
-	*x = Dict{}
+func (x *ClassDef) Reset() {
+	*x = ClassDef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[15]
+		mi := &file_ast_ast_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Dict) String() string {
+func (x *ClassDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Dict) ProtoMessage() {}
+func (*ClassDef) ProtoMessage() {}
 
-func (x *Dict) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[15]
+func (x *ClassDef) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,50 +2212,73 @@ func (x *Dict) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Dict.ProtoReflect.Descriptor instead.
-func (*Dict) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ClassDef.ProtoReflect.Descriptor instead.
+func (*ClassDef) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Dict) GetKeys() []*Node {
+func (x *ClassDef) GetName() string {
 	if x != nil {
-		return x.Keys
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClassDef) GetBases() []*Node {
+	if x != nil {
+		return x.Bases
 	}
 	return nil
 }
 
-func (x *Dict) GetValues() []*Node {
+func (x *ClassDef) GetKeywords() []*Node {
 	if x != nil {
-		return x.Values
+		return x.Keywords
 	}
 	return nil
 }
 
-type Expr struct {
+func (x *ClassDef) GetBody() []*Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *ClassDef) GetDecoratorList() []*Node {
+	if x != nil {
+		return x.DecoratorList
+	}
+	return nil
+}
+
+// The Python ast module does not parse comments. It's not clear if this is the
+// best way to support them in the AST
+type Comment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *Node `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *Expr) Reset() {
-	*x = Expr{}
+func (x *Comment) Reset() {
+	*x = Comment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[16]
+		mi := &file_ast_ast_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Expr) String() string {
+func (x *Comment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Expr) ProtoMessage() {}
+func (*Comment) ProtoMessage() {}
 
-func (x *Expr) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[16]
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,45 +2289,45 @@ func (x *Expr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Expr.ProtoReflect.Descriptor instead.
-func (*Expr) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Expr) GetValue() *Node {
+func (x *Comment) GetText() string {
 	if x != nil {
-		return x.Value
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-type For struct {
+type Compare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Target *Node   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	Iter   *Node   `protobuf:"bytes,2,opt,name=iter,proto3" json:"iter,omitempty"`
-	Body   []*Node `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
+	Left        *Node   `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Ops         []*Node `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+	Comparators []*Node `protobuf:"bytes,3,rep,name=comparators,proto3" json:"comparators,omitempty"`
 }
 
-func (x *For) Reset() {
-	*x = For{}
+func (x *Compare) Reset() {
+	*x = Compare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[17]
+		mi := &file_ast_ast_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *For) String() string {
+func (x *Compare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*For) ProtoMessage() {}
+func (*Compare) ProtoMessage() {}
 
-func (x *For) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[17]
+func (x *Compare) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,60 +2338,60 @@ func (x *For) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use For.ProtoReflect.Descriptor instead.
-func (*For) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Compare.ProtoReflect.Descriptor instead.
+func (*Compare) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *For) GetTarget() *Node {
+func (x *Compare) GetLeft() *Node {
 	if x != nil {
-		return x.Target
+		return x.Left
 	}
 	return nil
 }
 
-func (x *For) GetIter() *Node {
+func (x *Compare) GetOps() []*Node {
 	if x != nil {
-		return x.Iter
+		return x.Ops
 	}
 	return nil
 }
 
-func (x *For) GetBody() []*Node {
+func (x *Compare) GetComparators() []*Node {
 	if x != nil {
-		return x.Body
+		return x.Comparators
 	}
 	return nil
 }
 
-type FunctionDef struct {
+type Comprehension struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Args    *Arguments `protobuf:"bytes,2,opt,name=Args,json=args,proto3" json:"Args,omitempty"`
-	Body    []*Node    `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
-	Returns *Node      `protobuf:"bytes,4,opt,name=returns,proto3" json:"returns,omitempty"`
+	Target  *Node   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Iter    *Node   `protobuf:"bytes,2,opt,name=iter,proto3" json:"iter,omitempty"`
+	Ifs     []*Node `protobuf:"bytes,3,rep,name=ifs,proto3" json:"ifs,omitempty"`
+	IsAsync int32   `protobuf:"varint,4,opt,name=is_async,proto3" json:"is_async,omitempty"`
 }
 
-func (x *FunctionDef) Reset() {
-	*x = FunctionDef{}
+func (x *Comprehension) Reset() {
+	*x = Comprehension{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[18]
+		mi := &file_ast_ast_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FunctionDef) String() string {
+func (x *Comprehension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FunctionDef) ProtoMessage() {}
+func (*Comprehension) ProtoMessage() {}
 
-func (x *FunctionDef) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[18]
+func (x *Comprehension) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1572,66 +2402,71 @@ func (x *FunctionDef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FunctionDef.ProtoReflect.Descriptor instead.
-func (*FunctionDef) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Comprehension.ProtoReflect.Descriptor instead.
+func (*Comprehension) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *FunctionDef) GetName() string {
+func (x *Comprehension) GetTarget() *Node {
 	if x != nil {
-		return x.Name
+		return x.Target
 	}
-	return ""
+	return nil
 }
 
-func (x *FunctionDef) GetArgs() *Arguments {
+func (x *Comprehension) GetIter() *Node {
 	if x != nil {
-		return x.Args
+		return x.Iter
 	}
 	return nil
 }
 
-func (x *FunctionDef) GetBody() []*Node {
+func (x *Comprehension) GetIfs() []*Node {
 	if x != nil {
-		return x.Body
+		return x.Ifs
 	}
 	return nil
 }
 
-func (x *FunctionDef) GetReturns() *Node {
+func (x *Comprehension) GetIsAsync() int32 {
 	if x != nil {
-		return x.Returns
+		return x.IsAsync
 	}
-	return nil
+	return 0
 }
 
-type If struct {
+type Constant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Test   *Node   `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
-	Body   []*Node `protobuf:"bytes,2,rep,name=body,proto3" json:"body,omitempty"`
-	OrElse []*Node `protobuf:"bytes,3,rep,name=or_else,json=orelse,proto3" json:"or_else,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*Constant_Str
+	//	*Constant_Int
+	//	*Constant_None
+	//	*Constant_Ellipsis
+	//	*Constant_Bool
+	Value isConstant_Value `protobuf_oneof:"value"`
 }
 
-func (x *If) Reset() {
-	*x = If{}
+func (x *Constant) Reset() {
+	*x = Constant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[19]
+		mi := &file_ast_ast_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *If) String() string {
+func (x *Constant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*If) ProtoMessage() {}
+func (*Constant) ProtoMessage() {}
 
-func (x *If) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[19]
+func (x *Constant) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1642,57 +2477,110 @@ func (x *If) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use If.ProtoReflect.Descriptor instead.
-func (*If) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Constant.ProtoReflect.Descriptor instead.
+func (*Constant) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *If) GetTest() *Node {
-	if x != nil {
-		return x.Test
+func (m *Constant) GetValue() isConstant_Value {
+	if m != nil {
+		return m.Value
 	}
 	return nil
 }
 
-func (x *If) GetBody() []*Node {
-	if x != nil {
-		return x.Body
+func (x *Constant) GetStr() string {
+	if x, ok := x.GetValue().(*Constant_Str); ok {
+		return x.Str
 	}
-	return nil
+	return ""
 }
 
-func (x *If) GetOrElse() []*Node {
-	if x != nil {
-		return x.OrElse
+func (x *Constant) GetInt() int32 {
+	if x, ok := x.GetValue().(*Constant_Int); ok {
+		return x.Int
 	}
-	return nil
+	return 0
 }
 
-type Import struct {
+func (x *Constant) GetNone() bool {
+	if x, ok := x.GetValue().(*Constant_None); ok {
+		return x.None
+	}
+	return false
+}
+
+func (x *Constant) GetEllipsis() bool {
+	if x, ok := x.GetValue().(*Constant_Ellipsis); ok {
+		return x.Ellipsis
+	}
+	return false
+}
+
+func (x *Constant) GetBool() bool {
+	if x, ok := x.GetValue().(*Constant_Bool); ok {
+		return x.Bool
+	}
+	return false
+}
+
+type isConstant_Value interface {
+	isConstant_Value()
+}
+
+type Constant_Str struct {
+	Str string `protobuf:"bytes,1,opt,name=str,json=string,proto3,oneof"`
+}
+
+type Constant_Int struct {
+	Int int32 `protobuf:"varint,2,opt,name=int,proto3,oneof"`
+}
+
+type Constant_None struct {
+	None bool `protobuf:"varint,3,opt,name=none,proto3,oneof"`
+}
+
+type Constant_Ellipsis struct {
+	Ellipsis bool `protobuf:"varint,4,opt,name=ellipsis,proto3,oneof"`
+}
+
+type Constant_Bool struct {
+	Bool bool `protobuf:"varint,5,opt,name=bool,proto3,oneof"`
+}
+
+func (*Constant_Str) isConstant_Value() {}
+
+func (*Constant_Int) isConstant_Value() {}
+
+func (*Constant_None) isConstant_Value() {}
+
+func (*Constant_Ellipsis) isConstant_Value() {}
+
+func (*Constant_Bool) isConstant_Value() {}
+
+type Continue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Names []*Node `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
 }
 
-func (x *Import) Reset() {
-	*x = Import{}
+func (x *Continue) Reset() {
+	*x = Continue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[20]
+		mi := &file_ast_ast_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Import) String() string {
+func (x *Continue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Import) ProtoMessage() {}
+func (*Continue) ProtoMessage() {}
 
-func (x *Import) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[20]
+func (x *Continue) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,45 +2591,37 @@ func (x *Import) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Import.ProtoReflect.Descriptor instead.
-func (*Import) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *Import) GetNames() []*Node {
-	if x != nil {
-		return x.Names
-	}
-	return nil
+// Deprecated: Use Continue.ProtoReflect.Descriptor instead.
+func (*Continue) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{25}
 }
 
-type ImportFrom struct {
+type Dict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Module string  `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	Names  []*Node `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
-	Level  int32   `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Keys   []*Node `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Values []*Node `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *ImportFrom) Reset() {
-	*x = ImportFrom{}
+func (x *Dict) Reset() {
+	*x = Dict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[21]
+		mi := &file_ast_ast_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportFrom) String() string {
+func (x *Dict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportFrom) ProtoMessage() {}
+func (*Dict) ProtoMessage() {}
 
-func (x *ImportFrom) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[21]
+func (x *Dict) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1752,69 +2632,48 @@ func (x *ImportFrom) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportFrom.ProtoReflect.Descriptor instead.
-func (*ImportFrom) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *ImportFrom) GetModule() string {
-	if x != nil {
-		return x.Module
-	}
-	return ""
+// Deprecated: Use Dict.ProtoReflect.Descriptor instead.
+func (*Dict) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ImportFrom) GetNames() []*Node {
+func (x *Dict) GetKeys() []*Node {
 	if x != nil {
-		return x.Names
+		return x.Keys
 	}
 	return nil
 }
 
-func (x *ImportFrom) GetLevel() int32 {
+func (x *Dict) GetValues() []*Node {
 	if x != nil {
-		return x.Level
+		return x.Values
 	}
-	return 0
+	return nil
 }
 
-// Imports are always put at the top of the file, just after any module
-// comments and docstrings, and before module globals and constants.
-//
-// Imports should be grouped in the following order:
-//
-//	Standard library imports.
-//	Related third party imports.
-//	Local application/library specific imports.
-//
-// You should put a blank line between each group of imports.
-//
-// https://www.python.org/dev/peps/pep-0008/#imports
-type ImportGroup struct {
+type Div struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Imports []*Node `protobuf:"bytes,1,rep,name=imports,proto3" json:"imports,omitempty"`
 }
 
-func (x *ImportGroup) Reset() {
-	*x = ImportGroup{}
+func (x *Div) Reset() {
+	*x = Div{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[22]
+		mi := &file_ast_ast_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportGroup) String() string {
+func (x *Div) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportGroup) ProtoMessage() {}
+func (*Div) ProtoMessage() {}
 
-func (x *ImportGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[22]
+func (x *Div) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1825,41 +2684,34 @@ func (x *ImportGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportGroup.ProtoReflect.Descriptor instead.
-func (*ImportGroup) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ImportGroup) GetImports() []*Node {
-	if x != nil {
-		return x.Imports
-	}
-	return nil
+// Deprecated: Use Div.ProtoReflect.Descriptor instead.
+func (*Div) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{27}
 }
 
-type Is struct {
+type Eq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Is) Reset() {
-	*x = Is{}
+func (x *Eq) Reset() {
+	*x = Eq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[23]
+		mi := &file_ast_ast_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Is) String() string {
+func (x *Eq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Is) ProtoMessage() {}
+func (*Eq) ProtoMessage() {}
 
-func (x *Is) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[23]
+func (x *Eq) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,37 +2722,38 @@ func (x *Is) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Is.ProtoReflect.Descriptor instead.
-func (*Is) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Eq.ProtoReflect.Descriptor instead.
+func (*Eq) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{28}
 }
 
-type Keyword struct {
+type ExceptHandler struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Arg   string `protobuf:"bytes,1,opt,name=arg,proto3" json:"arg,omitempty"`
-	Value *Node  `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Type *Node   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Body []*Node `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
 }
 
-func (x *Keyword) Reset() {
-	*x = Keyword{}
+func (x *ExceptHandler) Reset() {
+	*x = ExceptHandler{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[24]
+		mi := &file_ast_ast_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Keyword) String() string {
+func (x *ExceptHandler) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Keyword) ProtoMessage() {}
+func (*ExceptHandler) ProtoMessage() {}
 
-func (x *Keyword) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[24]
+func (x *ExceptHandler) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,50 +2764,57 @@ func (x *Keyword) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Keyword.ProtoReflect.Descriptor instead.
-func (*Keyword) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ExceptHandler.ProtoReflect.Descriptor instead.
+func (*ExceptHandler) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Keyword) GetArg() string {
+func (x *ExceptHandler) GetType() *Node {
 	if x != nil {
-		return x.Arg
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ExceptHandler) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Keyword) GetValue() *Node {
+func (x *ExceptHandler) GetBody() []*Node {
 	if x != nil {
-		return x.Value
+		return x.Body
 	}
 	return nil
 }
 
-type Module struct {
+type Expr struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Body []*Node `protobuf:"bytes,1,rep,name=body,proto3" json:"body,omitempty"`
+	Value *Node `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Module) Reset() {
-	*x = Module{}
+func (x *Expr) Reset() {
+	*x = Expr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[25]
+		mi := &file_ast_ast_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Module) String() string {
+func (x *Expr) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Module) ProtoMessage() {}
+func (*Expr) ProtoMessage() {}
 
-func (x *Module) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[25]
+func (x *Expr) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,43 +2825,45 @@ func (x *Module) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Module.ProtoReflect.Descriptor instead.
-func (*Module) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Expr.ProtoReflect.Descriptor instead.
+func (*Expr) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Module) GetBody() []*Node {
+func (x *Expr) GetValue() *Node {
 	if x != nil {
-		return x.Body
+		return x.Value
 	}
 	return nil
 }
 
-type Name struct {
+type For struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target *Node   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Iter   *Node   `protobuf:"bytes,2,opt,name=iter,proto3" json:"iter,omitempty"`
+	Body   []*Node `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
 }
 
-func (x *Name) Reset() {
-	*x = Name{}
+func (x *For) Reset() {
+	*x = For{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[26]
+		mi := &file_ast_ast_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Name) String() string {
+func (x *For) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Name) ProtoMessage() {}
+func (*For) ProtoMessage() {}
 
-func (x *Name) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[26]
+func (x *For) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,41 +2874,55 @@ func (x *Name) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Name.ProtoReflect.Descriptor instead.
-func (*Name) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use For.ProtoReflect.Descriptor instead.
+func (*For) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Name) GetId() string {
+func (x *For) GetTarget() *Node {
 	if x != nil {
-		return x.Id
+		return x.Target
 	}
-	return ""
+	return nil
 }
 
-type Pass struct {
+func (x *For) GetIter() *Node {
+	if x != nil {
+		return x.Iter
+	}
+	return nil
+}
+
+func (x *For) GetBody() []*Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type FloorDiv struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Pass) Reset() {
-	*x = Pass{}
+func (x *FloorDiv) Reset() {
+	*x = FloorDiv{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[27]
+		mi := &file_ast_ast_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pass) String() string {
+func (x *FloorDiv) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pass) ProtoMessage() {}
+func (*FloorDiv) ProtoMessage() {}
 
-func (x *Pass) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[27]
+func (x *FloorDiv) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,36 +2933,40 @@ func (x *Pass) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pass.ProtoReflect.Descriptor instead.
-func (*Pass) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use FloorDiv.ProtoReflect.Descriptor instead.
+func (*FloorDiv) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{32}
 }
 
-type Return struct {
+type FunctionDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *Node `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Name          string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args          *Arguments `protobuf:"bytes,2,opt,name=Args,json=args,proto3" json:"Args,omitempty"`
+	Body          []*Node    `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty"`
+	Returns       *Node      `protobuf:"bytes,4,opt,name=returns,proto3" json:"returns,omitempty"`
+	DecoratorList []*Node    `protobuf:"bytes,5,rep,name=decorator_list,proto3" json:"decorator_list,omitempty"`
 }
 
-func (x *Return) Reset() {
-	*x = Return{}
+func (x *FunctionDef) Reset() {
+	*x = FunctionDef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[28]
+		mi := &file_ast_ast_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Return) String() string {
+func (x *FunctionDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Return) ProtoMessage() {}
+func (*FunctionDef) ProtoMessage() {}
 
-func (x *Return) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[28]
+func (x *FunctionDef) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2097,44 +2977,71 @@ func (x *Return) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Return.ProtoReflect.Descriptor instead.
-func (*Return) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use FunctionDef.ProtoReflect.Descriptor instead.
+func (*FunctionDef) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Return) GetValue() *Node {
+func (x *FunctionDef) GetName() string {
 	if x != nil {
-		return x.Value
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FunctionDef) GetArgs() *Arguments {
+	if x != nil {
+		return x.Args
 	}
 	return nil
 }
 
-type Subscript struct {
+func (x *FunctionDef) GetBody() []*Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *FunctionDef) GetReturns() *Node {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+func (x *FunctionDef) GetDecoratorList() []*Node {
+	if x != nil {
+		return x.DecoratorList
+	}
+	return nil
+}
+
+type Global struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *Name `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Slice *Node `protobuf:"bytes,2,opt,name=slice,proto3" json:"slice,omitempty"`
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
 }
 
-func (x *Subscript) Reset() {
-	*x = Subscript{}
+func (x *Global) Reset() {
+	*x = Global{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[29]
+		mi := &file_ast_ast_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Subscript) String() string {
+func (x *Global) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Subscript) ProtoMessage() {}
+func (*Global) ProtoMessage() {}
 
-func (x *Subscript) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[29]
+func (x *Global) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2145,50 +3052,184 @@ func (x *Subscript) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Subscript.ProtoReflect.Descriptor instead.
-func (*Subscript) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Global.ProtoReflect.Descriptor instead.
+func (*Global) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Subscript) GetValue() *Name {
+func (x *Global) GetNames() []string {
 	if x != nil {
-		return x.Value
+		return x.Names
 	}
 	return nil
 }
 
-func (x *Subscript) GetSlice() *Node {
+type Gt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Gt) Reset() {
+	*x = Gt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gt) ProtoMessage() {}
+
+func (x *Gt) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gt.ProtoReflect.Descriptor instead.
+func (*Gt) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{35}
+}
+
+type GtE struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GtE) Reset() {
+	*x = GtE{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GtE) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GtE) ProtoMessage() {}
+
+func (x *GtE) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GtE.ProtoReflect.Descriptor instead.
+func (*GtE) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{36}
+}
+
+type If struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Test   *Node   `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
+	Body   []*Node `protobuf:"bytes,2,rep,name=body,proto3" json:"body,omitempty"`
+	OrElse []*Node `protobuf:"bytes,3,rep,name=or_else,json=orelse,proto3" json:"or_else,omitempty"`
+}
+
+func (x *If) Reset() {
+	*x = If{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *If) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*If) ProtoMessage() {}
+
+func (x *If) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use If.ProtoReflect.Descriptor instead.
+func (*If) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *If) GetTest() *Node {
 	if x != nil {
-		return x.Slice
+		return x.Test
 	}
 	return nil
 }
 
-type Yield struct {
+func (x *If) GetBody() []*Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *If) GetOrElse() []*Node {
+	if x != nil {
+		return x.OrElse
+	}
+	return nil
+}
+
+type IfExp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *Node `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Test   *Node `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
+	Body   *Node `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	OrElse *Node `protobuf:"bytes,3,opt,name=or_else,json=orelse,proto3" json:"or_else,omitempty"`
 }
 
-func (x *Yield) Reset() {
-	*x = Yield{}
+func (x *IfExp) Reset() {
+	*x = IfExp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ast_ast_proto_msgTypes[30]
+		mi := &file_ast_ast_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Yield) String() string {
+func (x *IfExp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Yield) ProtoMessage() {}
+func (*IfExp) ProtoMessage() {}
 
-func (x *Yield) ProtoReflect() protoreflect.Message {
-	mi := &file_ast_ast_proto_msgTypes[30]
+func (x *IfExp) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,391 +3240,2946 @@ func (x *Yield) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Yield.ProtoReflect.Descriptor instead.
-func (*Yield) Descriptor() ([]byte, []int) {
-	return file_ast_ast_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use IfExp.ProtoReflect.Descriptor instead.
+func (*IfExp) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Yield) GetValue() *Node {
+func (x *IfExp) GetTest() *Node {
 	if x != nil {
-		return x.Value
+		return x.Test
 	}
 	return nil
 }
 
-var File_ast_ast_proto protoreflect.FileDescriptor
+func (x *IfExp) GetBody() *Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
 
-var file_ast_ast_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x61, 0x73, 0x74, 0x2f, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x73, 0x74, 0x22, 0xde, 0x09, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a,
-	0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x65, 0x66, 0x48,
-	0x00, 0x52, 0x08, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x06, 0x69,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73,
-	0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x06, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x0a,
-	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
-	0x73, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x48, 0x00, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x61,
-	0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x61, 0x6e, 0x6e, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x41, 0x6e, 0x6e, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x00, 0x52, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x48, 0x00, 0x52,
-	0x06, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x1f, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x04, 0x45, 0x78,
-	0x70, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x43,
-	0x61, 0x6c, 0x6c, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x65, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x73, 0x74, 0x2e,
-	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0b, 0x46,
-	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x72,
-	0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72,
-	0x67, 0x48, 0x00, 0x52, 0x03, 0x41, 0x72, 0x67, 0x12, 0x2e, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73,
-	0x74, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x09, 0x41,
-	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x12, 0x61, 0x73, 0x79, 0x6e,
-	0x63, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63,
-	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x10, 0x41,
-	0x73, 0x79, 0x6e, 0x63, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x12,
-	0x1f, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x61, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x73, 0x73,
-	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x63, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x48, 0x00, 0x52, 0x04, 0x44, 0x69, 0x63,
-	0x74, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x66, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
-	0x61, 0x73, 0x74, 0x2e, 0x49, 0x66, 0x48, 0x00, 0x52, 0x02, 0x49, 0x66, 0x12, 0x28, 0x0a, 0x07,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x07, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x19, 0x0a,
-	0x02, 0x69, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x73, 0x74, 0x2e,
-	0x49, 0x73, 0x48, 0x00, 0x52, 0x02, 0x49, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e,
-	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52,
-	0x05, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x03, 0x66, 0x6f, 0x72, 0x18, 0x1b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x48, 0x00, 0x52,
-	0x03, 0x46, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x77, 0x61, 0x69, 0x74, 0x18, 0x1c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x48,
-	0x00, 0x52, 0x05, 0x41, 0x77, 0x61, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x73, 0x79, 0x6e,
-	0x63, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73,
-	0x74, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x08, 0x41, 0x73,
-	0x79, 0x6e, 0x63, 0x46, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
-	0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00,
-	0x52, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x06, 0x0a,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x1b, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x28, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x40, 0x0a, 0x09,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x74,
-	0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x22, 0x8b,
-	0x01, 0x0a, 0x09, 0x41, 0x6e, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x21, 0x0a, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
-	0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x29, 0x0a, 0x0a, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a,
-	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x03,
-	0x41, 0x72, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x72, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x61, 0x72, 0x67, 0x12, 0x29, 0x0a, 0x0a, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x55, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a,
-	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73,
-	0x74, 0x2e, 0x41, 0x72, 0x67, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x0c, 0x6b,
-	0x77, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x67, 0x52, 0x0a, 0x6b, 0x77, 0x6f,
-	0x6e, 0x6c, 0x79, 0x61, 0x72, 0x67, 0x73, 0x22, 0x6b, 0x0a, 0x08, 0x41, 0x73, 0x79, 0x6e, 0x63,
-	0x46, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x04, 0x69, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
-	0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73,
-	0x74, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x04, 0x61, 0x72, 0x67,
-	0x73, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x12, 0x23, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x73, 0x22, 0x68, 0x0a, 0x06, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12,
-	0x23, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0x6e, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x12, 0x1d, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4b, 0x65,
-	0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22,
-	0xb8, 0x01, 0x0a, 0x08, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65,
-	0x73, 0x12, 0x25, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08,
-	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x31, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x6f, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x6f,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x07, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x72, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6c,
-	0x65, 0x66, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x6f, 0x70, 0x73,
-	0x12, 0x2b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x54, 0x0a,
-	0x08, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x74, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x12, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
-	0x03, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x48, 0x0a, 0x04, 0x44, 0x69, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6b,
-	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x27, 0x0a,
-	0x04, 0x45, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x66, 0x0a, 0x03, 0x46, 0x6f, 0x72, 0x12, 0x21, 0x0a,
-	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x12, 0x1d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x72, 0x12,
-	0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x89,
-	0x01, 0x0a, 0x0b, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x22, 0x66, 0x0a, 0x02, 0x49, 0x66,
-	0x12, 0x1d, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x22,
-	0x0a, 0x07, 0x6f, 0x72, 0x5f, 0x65, 0x6c, 0x73, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x6f, 0x72, 0x65, 0x6c,
-	0x73, 0x65, 0x22, 0x29, 0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x05,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73,
-	0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5b, 0x0a,
-	0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x32, 0x0a, 0x0b, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x07, 0x69, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x04,
-	0x0a, 0x02, 0x49, 0x73, 0x22, 0x3c, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x72, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x72,
-	0x67, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x27, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x16, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x06, 0x0a, 0x04, 0x50, 0x61, 0x73, 0x73, 0x22, 0x29, 0x0a, 0x06, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
-	0x73, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x28, 0x0a, 0x05, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x71, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x73, 0x74, 0x42, 0x08, 0x41, 0x73, 0x74, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x73, 0x71, 0x6c, 0x63, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x73, 0x71, 0x6c, 0x63,
-	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x73, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02,
-	0x03, 0x41, 0x73, 0x74, 0xca, 0x02, 0x03, 0x41, 0x73, 0x74, 0xe2, 0x02, 0x0f, 0x41, 0x73, 0x74,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x03, 0x41,
-	0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *IfExp) GetOrElse() *Node {
+	if x != nil {
+		return x.OrElse
+	}
+	return nil
 }
 
-var (
-	file_ast_ast_proto_rawDescOnce sync.Once
-	file_ast_ast_proto_rawDescData = file_ast_ast_proto_rawDesc
-)
+type Import struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_ast_ast_proto_rawDescGZIP() []byte {
-	file_ast_ast_proto_rawDescOnce.Do(func() {
-		file_ast_ast_proto_rawDescData = protoimpl.X.CompressGZIP(file_ast_ast_proto_rawDescData)
-	})
-	return file_ast_ast_proto_rawDescData
+	Names []*Node `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
 }
 
-var file_ast_ast_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
-var file_ast_ast_proto_goTypes = []interface{}{
-	(*Node)(nil),             // 0: ast.Node
-	(*Alias)(nil),            // 1: ast.Alias
-	(*Await)(nil),            // 2: ast.Await
-	(*Attribute)(nil),        // 3: ast.Attribute
-	(*AnnAssign)(nil),        // 4: ast.AnnAssign
-	(*Arg)(nil),              // 5: ast.Arg
-	(*Arguments)(nil),        // 6: ast.Arguments
-	(*AsyncFor)(nil),         // 7: ast.AsyncFor
-	(*AsyncFunctionDef)(nil), // 8: ast.AsyncFunctionDef
-	(*Assign)(nil),           // 9: ast.Assign
-	(*Call)(nil),             // 10: ast.Call
-	(*ClassDef)(nil),         // 11: ast.ClassDef
-	(*Comment)(nil),          // 12: ast.Comment
-	(*Compare)(nil),          // 13: ast.Compare
-	(*Constant)(nil),         // 14: ast.Constant
-	(*Dict)(nil),             // 15: ast.Dict
-	(*Expr)(nil),             // 16: ast.Expr
-	(*For)(nil),              // 17: ast.For
-	(*FunctionDef)(nil),      // 18: ast.FunctionDef
-	(*If)(nil),               // 19: ast.If
-	(*Import)(nil),           // 20: ast.Import
-	(*ImportFrom)(nil),       // 21: ast.ImportFrom
-	(*ImportGroup)(nil),      // 22: ast.ImportGroup
-	(*Is)(nil),               // 23: ast.Is
-	(*Keyword)(nil),          // 24: ast.Keyword
-	(*Module)(nil),           // 25: ast.Module
-	(*Name)(nil),             // 26: ast.Name
-	(*Pass)(nil),             // 27: ast.Pass
-	(*Return)(nil),           // 28: ast.Return
-	(*Subscript)(nil),        // 29: ast.Subscript
-	(*Yield)(nil),            // 30: ast.Yield
+func (x *Import) Reset() {
+	*x = Import{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_ast_ast_proto_depIdxs = []int32{
-	11, // 0: ast.Node.class_def:type_name -> ast.ClassDef
-	20, // 1: ast.Node.import:type_name -> ast.Import
-	21, // 2: ast.Node.import_from:type_name -> ast.ImportFrom
-	25, // 3: ast.Node.module:type_name -> ast.Module
-	1,  // 4: ast.Node.alias:type_name -> ast.Alias
-	4,  // 5: ast.Node.ann_assign:type_name -> ast.AnnAssign
-	26, // 6: ast.Node.name:type_name -> ast.Name
-	29, // 7: ast.Node.subscript:type_name -> ast.Subscript
-	3,  // 8: ast.Node.attribute:type_name -> ast.Attribute
-	14, // 9: ast.Node.constant:type_name -> ast.Constant
-	9,  // 10: ast.Node.assign:type_name -> ast.Assign
-	12, // 11: ast.Node.comment:type_name -> ast.Comment
-	16, // 12: ast.Node.expr:type_name -> ast.Expr
-	10, // 13: ast.Node.call:type_name -> ast.Call
-	18, // 14: ast.Node.function_def:type_name -> ast.FunctionDef
-	5,  // 15: ast.Node.arg:type_name -> ast.Arg
-	6,  // 16: ast.Node.arguments:type_name -> ast.Arguments
-	8,  // 17: ast.Node.async_function_def:type_name -> ast.AsyncFunctionDef
-	27, // 18: ast.Node.pass:type_name -> ast.Pass
-	15, // 19: ast.Node.dict:type_name -> ast.Dict
-	19, // 20: ast.Node.if:type_name -> ast.If
-	13, // 21: ast.Node.compare:type_name -> ast.Compare
-	28, // 22: ast.Node.return:type_name -> ast.Return
-	23, // 23: ast.Node.is:type_name -> ast.Is
-	24, // 24: ast.Node.keyword:type_name -> ast.Keyword
-	30, // 25: ast.Node.yield:type_name -> ast.Yield
-	17, // 26: ast.Node.for:type_name -> ast.For
-	2,  // 27: ast.Node.await:type_name -> ast.Await
-	7,  // 28: ast.Node.async_for:type_name -> ast.AsyncFor
-	22, // 29: ast.Node.import_group:type_name -> ast.ImportGroup
-	0,  // 30: ast.Await.value:type_name -> ast.Node
-	0,  // 31: ast.Attribute.value:type_name -> ast.Node
-	26, // 32: ast.AnnAssign.target:type_name -> ast.Name
-	0,  // 33: ast.AnnAssign.annotation:type_name -> ast.Node
-	0,  // 34: ast.Arg.annotation:type_name -> ast.Node
-	5,  // 35: ast.Arguments.args:type_name -> ast.Arg
-	5,  // 36: ast.Arguments.kw_only_args:type_name -> ast.Arg
-	0,  // 37: ast.AsyncFor.target:type_name -> ast.Node
-	0,  // 38: ast.AsyncFor.iter:type_name -> ast.Node
-	0,  // 39: ast.AsyncFor.body:type_name -> ast.Node
-	6,  // 40: ast.AsyncFunctionDef.Args:type_name -> ast.Arguments
-	0,  // 41: ast.AsyncFunctionDef.body:type_name -> ast.Node
-	0,  // 42: ast.AsyncFunctionDef.returns:type_name -> ast.Node
-	0,  // 43: ast.Assign.targets:type_name -> ast.Node
-	0,  // 44: ast.Assign.value:type_name -> ast.Node
-	0,  // 45: ast.Call.func:type_name -> ast.Node
-	0,  // 46: ast.Call.args:type_name -> ast.Node
-	24, // 47: ast.Call.keywords:type_name -> ast.Keyword
-	0,  // 48: ast.ClassDef.bases:type_name -> ast.Node
-	0,  // 49: ast.ClassDef.keywords:type_name -> ast.Node
-	0,  // 50: ast.ClassDef.body:type_name -> ast.Node
-	0,  // 51: ast.ClassDef.decorator_list:type_name -> ast.Node
-	0,  // 52: ast.Compare.left:type_name -> ast.Node
-	0,  // 53: ast.Compare.ops:type_name -> ast.Node
-	0,  // 54: ast.Compare.comparators:type_name -> ast.Node
-	0,  // 55: ast.Dict.keys:type_name -> ast.Node
-	0,  // 56: ast.Dict.values:type_name -> ast.Node
-	0,  // 57: ast.Expr.value:type_name -> ast.Node
-	0,  // 58: ast.For.target:type_name -> ast.Node
-	0,  // 59: ast.For.iter:type_name -> ast.Node
-	0,  // 60: ast.For.body:type_name -> ast.Node
-	6,  // 61: ast.FunctionDef.Args:type_name -> ast.Arguments
-	0,  // 62: ast.FunctionDef.body:type_name -> ast.Node
-	0,  // 63: ast.FunctionDef.returns:type_name -> ast.Node
-	0,  // 64: ast.If.test:type_name -> ast.Node
-	0,  // 65: ast.If.body:type_name -> ast.Node
-	0,  // 66: ast.If.or_else:type_name -> ast.Node
-	0,  // 67: ast.Import.names:type_name -> ast.Node
-	0,  // 68: ast.ImportFrom.names:type_name -> ast.Node
-	0,  // 69: ast.ImportGroup.imports:type_name -> ast.Node
-	0,  // 70: ast.Keyword.value:type_name -> ast.Node
-	0,  // 71: ast.Module.body:type_name -> ast.Node
-	0,  // 72: ast.Return.value:type_name -> ast.Node
-	26, // 73: ast.Subscript.value:type_name -> ast.Name
-	0,  // 74: ast.Subscript.slice:type_name -> ast.Node
-	0,  // 75: ast.Yield.value:type_name -> ast.Node
-	76, // [76:76] is the sub-list for method output_type
-	76, // [76:76] is the sub-list for method input_type
-	76, // [76:76] is the sub-list for extension type_name
-	76, // [76:76] is the sub-list for extension extendee
-	0,  // [0:76] is the sub-list for field type_name
+
+func (x *Import) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_ast_ast_proto_init() }
-func file_ast_ast_proto_init() {
-	if File_ast_ast_proto != nil {
-		return
+func (*Import) ProtoMessage() {}
+
+func (x *Import) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_ast_ast_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Node); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Import.ProtoReflect.Descriptor instead.
+func (*Import) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Import) GetNames() []*Node {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type ImportFrom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module string  `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Names  []*Node `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Level  int32   `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *ImportFrom) Reset() {
+	*x = ImportFrom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportFrom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFrom) ProtoMessage() {}
+
+func (x *ImportFrom) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFrom.ProtoReflect.Descriptor instead.
+func (*ImportFrom) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportFrom) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *ImportFrom) GetNames() []*Node {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ImportFrom) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// Imports are always put at the top of the file, just after any module
+// comments and docstrings, and before module globals and constants.
+//
+// Imports should be grouped in the following order:
+//
+//	Standard library imports.
+//	Related third party imports.
+//	Local application/library specific imports.
+//
+// You should put a blank line between each group of imports.
+//
+// https://www.python.org/dev/peps/pep-0008/#imports
+type ImportGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imports []*Node `protobuf:"bytes,1,rep,name=imports,proto3" json:"imports,omitempty"`
+}
+
+func (x *ImportGroup) Reset() {
+	*x = ImportGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGroup) ProtoMessage() {}
+
+func (x *ImportGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGroup.ProtoReflect.Descriptor instead.
+func (*ImportGroup) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ImportGroup) GetImports() []*Node {
+	if x != nil {
+		return x.Imports
+	}
+	return nil
+}
+
+type In struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *In) Reset() {
+	*x = In{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *In) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*In) ProtoMessage() {}
+
+func (x *In) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use In.ProtoReflect.Descriptor instead.
+func (*In) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{42}
+}
+
+type Invert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Invert) Reset() {
+	*x = Invert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invert) ProtoMessage() {}
+
+func (x *Invert) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invert.ProtoReflect.Descriptor instead.
+func (*Invert) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{43}
+}
+
+type Is struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Is) Reset() {
+	*x = Is{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Is) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Is) ProtoMessage() {}
+
+func (x *Is) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Is.ProtoReflect.Descriptor instead.
+func (*Is) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{44}
+}
+
+type IsNot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IsNot) Reset() {
+	*x = IsNot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsNot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsNot) ProtoMessage() {}
+
+func (x *IsNot) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsNot.ProtoReflect.Descriptor instead.
+func (*IsNot) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{45}
+}
+
+type Keyword struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Arg   string `protobuf:"bytes,1,opt,name=arg,proto3" json:"arg,omitempty"`
+	Value *Node  `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Keyword) Reset() {
+	*x = Keyword{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Keyword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Keyword) ProtoMessage() {}
+
+func (x *Keyword) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Keyword.ProtoReflect.Descriptor instead.
+func (*Keyword) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Keyword) GetArg() string {
+	if x != nil {
+		return x.Arg
+	}
+	return ""
+}
+
+func (x *Keyword) GetValue() *Node {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Lambda struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Args *Arguments `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	Body *Node      `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *Lambda) Reset() {
+	*x = Lambda{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lambda) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lambda) ProtoMessage() {}
+
+func (x *Lambda) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lambda.ProtoReflect.Descriptor instead.
+func (*Lambda) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Lambda) GetArgs() *Arguments {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Lambda) GetBody() *Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elts []*Node `protobuf:"bytes,1,rep,name=elts,proto3" json:"elts,omitempty"`
+}
+
+func (x *List) Reset() {
+	*x = List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*List) ProtoMessage() {}
+
+func (x *List) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use List.ProtoReflect.Descriptor instead.
+func (*List) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *List) GetElts() []*Node {
+	if x != nil {
+		return x.Elts
+	}
+	return nil
+}
+
+type ListComp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elt        *Node            `protobuf:"bytes,1,opt,name=elt,proto3" json:"elt,omitempty"`
+	Generators []*Comprehension `protobuf:"bytes,2,rep,name=generators,proto3" json:"generators,omitempty"`
+}
+
+func (x *ListComp) Reset() {
+	*x = ListComp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListComp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComp) ProtoMessage() {}
+
+func (x *ListComp) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComp.ProtoReflect.Descriptor instead.
+func (*ListComp) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListComp) GetElt() *Node {
+	if x != nil {
+		return x.Elt
+	}
+	return nil
+}
+
+func (x *ListComp) GetGenerators() []*Comprehension {
+	if x != nil {
+		return x.Generators
+	}
+	return nil
+}
+
+type LShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LShift) Reset() {
+	*x = LShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LShift) ProtoMessage() {}
+
+func (x *LShift) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LShift.ProtoReflect.Descriptor instead.
+func (*LShift) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{50}
+}
+
+type Lt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Lt) Reset() {
+	*x = Lt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lt) ProtoMessage() {}
+
+func (x *Lt) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lt.ProtoReflect.Descriptor instead.
+func (*Lt) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{51}
+}
+
+type LtE struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LtE) Reset() {
+	*x = LtE{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LtE) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtE) ProtoMessage() {}
+
+func (x *LtE) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtE.ProtoReflect.Descriptor instead.
+func (*LtE) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{52}
+}
+
+type MatMult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MatMult) Reset() {
+	*x = MatMult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatMult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatMult) ProtoMessage() {}
+
+func (x *MatMult) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatMult.ProtoReflect.Descriptor instead.
+func (*MatMult) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{53}
+}
+
+type Mod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Mod) Reset() {
+	*x = Mod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mod) ProtoMessage() {}
+
+func (x *Mod) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mod.ProtoReflect.Descriptor instead.
+func (*Mod) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{54}
+}
+
+type Module struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Body []*Node `protobuf:"bytes,1,rep,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+func (x *Module) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Module) GetBody() []*Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type Mult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Mult) Reset() {
+	*x = Mult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mult) ProtoMessage() {}
+
+func (x *Mult) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mult.ProtoReflect.Descriptor instead.
+func (*Mult) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{56}
+}
+
+type Name struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Name) Reset() {
+	*x = Name{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Name) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Name) ProtoMessage() {}
+
+func (x *Name) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Name.ProtoReflect.Descriptor instead.
+func (*Name) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Name) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Not struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Not) Reset() {
+	*x = Not{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Not) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Not) ProtoMessage() {}
+
+func (x *Not) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Not.ProtoReflect.Descriptor instead.
+func (*Not) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{58}
+}
+
+type NotEq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NotEq) Reset() {
+	*x = NotEq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotEq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotEq) ProtoMessage() {}
+
+func (x *NotEq) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotEq.ProtoReflect.Descriptor instead.
+func (*NotEq) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{59}
+}
+
+type NotIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NotIn) Reset() {
+	*x = NotIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotIn) ProtoMessage() {}
+
+func (x *NotIn) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotIn.ProtoReflect.Descriptor instead.
+func (*NotIn) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{60}
+}
+
+type Or struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Or) Reset() {
+	*x = Or{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Or) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Or) ProtoMessage() {}
+
+func (x *Or) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Or.ProtoReflect.Descriptor instead.
+func (*Or) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{61}
+}
+
+type Pass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Pass) Reset() {
+	*x = Pass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pass) ProtoMessage() {}
+
+func (x *Pass) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pass.ProtoReflect.Descriptor instead.
+func (*Pass) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{62}
+}
+
+type Pow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Pow) Reset() {
+	*x = Pow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pow) ProtoMessage() {}
+
+func (x *Pow) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pow.ProtoReflect.Descriptor instead.
+func (*Pow) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{63}
+}
+
+type Raise struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exc   *Node `protobuf:"bytes,1,opt,name=exc,proto3" json:"exc,omitempty"`
+	Cause *Node `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+}
+
+func (x *Raise) Reset() {
+	*x = Raise{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Raise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Raise) ProtoMessage() {}
+
+func (x *Raise) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Raise.ProtoReflect.Descriptor instead.
+func (*Raise) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Raise) GetExc() *Node {
+	if x != nil {
+		return x.Exc
+	}
+	return nil
+}
+
+func (x *Raise) GetCause() *Node {
+	if x != nil {
+		return x.Cause
+	}
+	return nil
+}
+
+type Return struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Node `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Return) Reset() {
+	*x = Return{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Return) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Return) ProtoMessage() {}
+
+func (x *Return) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Return.ProtoReflect.Descriptor instead.
+func (*Return) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Return) GetValue() *Node {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type RShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RShift) Reset() {
+	*x = RShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RShift) ProtoMessage() {}
+
+func (x *RShift) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RShift.ProtoReflect.Descriptor instead.
+func (*RShift) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{66}
+}
+
+type Starred struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Node `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Starred) Reset() {
+	*x = Starred{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Starred) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Starred) ProtoMessage() {}
+
+func (x *Starred) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Starred.ProtoReflect.Descriptor instead.
+func (*Starred) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Starred) GetValue() *Node {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Sub struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Sub) Reset() {
+	*x = Sub{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sub) ProtoMessage() {}
+
+func (x *Sub) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sub.ProtoReflect.Descriptor instead.
+func (*Sub) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{68}
+}
+
+type Subscript struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Node `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Slice *Node `protobuf:"bytes,2,opt,name=slice,proto3" json:"slice,omitempty"`
+}
+
+func (x *Subscript) Reset() {
+	*x = Subscript{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscript) ProtoMessage() {}
+
+func (x *Subscript) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscript.ProtoReflect.Descriptor instead.
+func (*Subscript) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Subscript) GetValue() *Node {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Subscript) GetSlice() *Node {
+	if x != nil {
+		return x.Slice
+	}
+	return nil
+}
+
+type Try struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Body      []*Node          `protobuf:"bytes,1,rep,name=body,proto3" json:"body,omitempty"`
+	Handlers  []*ExceptHandler `protobuf:"bytes,2,rep,name=handlers,proto3" json:"handlers,omitempty"`
+	OrElse    []*Node          `protobuf:"bytes,3,rep,name=or_else,json=orelse,proto3" json:"or_else,omitempty"`
+	FinalBody []*Node          `protobuf:"bytes,4,rep,name=final_body,json=finalbody,proto3" json:"final_body,omitempty"`
+}
+
+func (x *Try) Reset() {
+	*x = Try{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Try) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Try) ProtoMessage() {}
+
+func (x *Try) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Try.ProtoReflect.Descriptor instead.
+func (*Try) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Try) GetBody() []*Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Try) GetHandlers() []*ExceptHandler {
+	if x != nil {
+		return x.Handlers
+	}
+	return nil
+}
+
+func (x *Try) GetOrElse() []*Node {
+	if x != nil {
+		return x.OrElse
+	}
+	return nil
+}
+
+func (x *Try) GetFinalBody() []*Node {
+	if x != nil {
+		return x.FinalBody
+	}
+	return nil
+}
+
+type Tuple struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elts []*Node `protobuf:"bytes,1,rep,name=elts,proto3" json:"elts,omitempty"`
+}
+
+func (x *Tuple) Reset() {
+	*x = Tuple{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tuple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tuple) ProtoMessage() {}
+
+func (x *Tuple) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tuple.ProtoReflect.Descriptor instead.
+func (*Tuple) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Tuple) GetElts() []*Node {
+	if x != nil {
+		return x.Elts
+	}
+	return nil
+}
+
+type TypeAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  *Name `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value *Node `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TypeAlias) Reset() {
+	*x = TypeAlias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeAlias) ProtoMessage() {}
+
+func (x *TypeAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeAlias.ProtoReflect.Descriptor instead.
+func (*TypeAlias) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TypeAlias) GetName() *Name {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *TypeAlias) GetValue() *Node {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type UAdd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UAdd) Reset() {
+	*x = UAdd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UAdd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UAdd) ProtoMessage() {}
+
+func (x *UAdd) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UAdd.ProtoReflect.Descriptor instead.
+func (*UAdd) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{73}
+}
+
+type UnaryOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op      *Node `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Operand *Node `protobuf:"bytes,2,opt,name=operand,proto3" json:"operand,omitempty"`
+}
+
+func (x *UnaryOp) Reset() {
+	*x = UnaryOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnaryOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnaryOp) ProtoMessage() {}
+
+func (x *UnaryOp) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnaryOp.ProtoReflect.Descriptor instead.
+func (*UnaryOp) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UnaryOp) GetOp() *Node {
+	if x != nil {
+		return x.Op
+	}
+	return nil
+}
+
+func (x *UnaryOp) GetOperand() *Node {
+	if x != nil {
+		return x.Operand
+	}
+	return nil
+}
+
+type USub struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *USub) Reset() {
+	*x = USub{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *USub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*USub) ProtoMessage() {}
+
+func (x *USub) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use USub.ProtoReflect.Descriptor instead.
+func (*USub) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{75}
+}
+
+type With struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WithItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Body  []*Node     `protobuf:"bytes,2,rep,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *With) Reset() {
+	*x = With{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *With) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*With) ProtoMessage() {}
+
+func (x *With) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use With.ProtoReflect.Descriptor instead.
+func (*With) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *With) GetItems() []*WithItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *With) GetBody() []*Node {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type WithItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextExpr  *Node `protobuf:"bytes,1,opt,name=context_expr,proto3" json:"context_expr,omitempty"`
+	OptionalVars *Node `protobuf:"bytes,2,opt,name=optional_vars,proto3" json:"optional_vars,omitempty"`
+}
+
+func (x *WithItem) Reset() {
+	*x = WithItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithItem) ProtoMessage() {}
+
+func (x *WithItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithItem.ProtoReflect.Descriptor instead.
+func (*WithItem) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *WithItem) GetContextExpr() *Node {
+	if x != nil {
+		return x.ContextExpr
+	}
+	return nil
+}
+
+func (x *WithItem) GetOptionalVars() *Node {
+	if x != nil {
+		return x.OptionalVars
+	}
+	return nil
+}
+
+type Yield struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Node `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Yield) Reset() {
+	*x = Yield{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ast_ast_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Yield) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Yield) ProtoMessage() {}
+
+func (x *Yield) ProtoReflect() protoreflect.Message {
+	mi := &file_ast_ast_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Yield.ProtoReflect.Descriptor instead.
+func (*Yield) Descriptor() ([]byte, []int) {
+	return file_ast_ast_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Yield) GetValue() *Node {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+var File_ast_ast_proto protoreflect.FileDescriptor
+
+var file_ast_ast_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x61, 0x73, 0x74, 0x2f, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x61, 0x73, 0x74, 0x22, 0xac, 0x16, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a,
+	0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x65, 0x66, 0x48,
+	0x00, 0x52, 0x08, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x06, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x06, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x0a,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x48, 0x00, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x61, 0x6e, 0x6e, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x41, 0x6e, 0x6e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x00, 0x52, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x48, 0x00, 0x52,
+	0x06, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x48, 0x00, 0x52, 0x04, 0x45, 0x78,
+	0x70, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x43,
+	0x61, 0x6c, 0x6c, 0x12, 0x35, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x73, 0x74, 0x2e,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0b, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x72,
+	0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72,
+	0x67, 0x48, 0x00, 0x52, 0x03, 0x41, 0x72, 0x67, 0x12, 0x2e, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x09, 0x41,
+	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x12, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x10, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x12,
+	0x1f, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x48, 0x00, 0x52, 0x04, 0x50, 0x61, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x63, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x48, 0x00, 0x52, 0x04, 0x44, 0x69, 0x63,
+	0x74, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x66, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x49, 0x66, 0x48, 0x00, 0x52, 0x02, 0x49, 0x66, 0x12, 0x28, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x07, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x19, 0x0a,
+	0x02, 0x69, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x73, 0x74, 0x2e,
+	0x49, 0x73, 0x48, 0x00, 0x52, 0x02, 0x49, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52,
+	0x05, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x03, 0x66, 0x6f, 0x72, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x03, 0x46, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x77, 0x61, 0x69, 0x74, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x77, 0x61, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x05, 0x41, 0x77, 0x61, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x08, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x46, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00,
+	0x52, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x05, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x05, 0x54, 0x75, 0x70,
+	0x6c, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x5f, 0x6f, 0x70, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x69, 0x6e, 0x4f, 0x70, 0x48, 0x00,
+	0x52, 0x05, 0x42, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x6f, 0x70, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x48, 0x00, 0x52, 0x06, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x12,
+	0x29, 0x0a, 0x08, 0x75, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x6f, 0x70, 0x18, 0x23, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x70, 0x48,
+	0x00, 0x52, 0x07, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x70, 0x12, 0x25, 0x0a, 0x06, 0x6c, 0x61,
+	0x6d, 0x62, 0x64, 0x61, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x48, 0x00, 0x52, 0x06, 0x4c, 0x61, 0x6d, 0x62, 0x64,
+	0x61, 0x12, 0x23, 0x0a, 0x06, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x66, 0x45, 0x78, 0x70, 0x48, 0x00, 0x52,
+	0x05, 0x49, 0x66, 0x45, 0x78, 0x70, 0x12, 0x28, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65,
+	0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64,
+	0x12, 0x2c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x18, 0x27, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x12, 0x22,
+	0x0a, 0x05, 0x72, 0x61, 0x69, 0x73, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x52, 0x61, 0x69, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x52, 0x61, 0x69,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x74, 0x72, 0x79, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x79, 0x48, 0x00, 0x52, 0x03, 0x54, 0x72, 0x79,
+	0x12, 0x1f, 0x0a, 0x04, 0x77, 0x69, 0x74, 0x68, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x48, 0x00, 0x52, 0x04, 0x57, 0x69, 0x74,
+	0x68, 0x12, 0x2f, 0x0a, 0x0a, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18,
+	0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x57, 0x69, 0x74, 0x68, 0x48, 0x00, 0x52, 0x09, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x57, 0x69,
+	0x74, 0x68, 0x12, 0x25, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x2c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x48,
+	0x00, 0x52, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x48, 0x00, 0x52,
+	0x09, 0x54, 0x79, 0x70, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x64,
+	0x64, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x64,
+	0x64, 0x48, 0x00, 0x52, 0x03, 0x41, 0x64, 0x64, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x75, 0x62, 0x18,
+	0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x48,
+	0x00, 0x52, 0x03, 0x53, 0x75, 0x62, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x75, 0x6c, 0x74, 0x18, 0x30,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x48,
+	0x00, 0x52, 0x04, 0x4d, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x5f, 0x6d,
+	0x75, 0x6c, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e,
+	0x4d, 0x61, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x07, 0x4d, 0x61, 0x74, 0x4d, 0x75,
+	0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x64, 0x69, 0x76, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x76, 0x48, 0x00, 0x52, 0x03, 0x44, 0x69, 0x76,
+	0x12, 0x1c, 0x0a, 0x03, 0x6d, 0x6f, 0x64, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x48, 0x00, 0x52, 0x03, 0x4d, 0x6f, 0x64, 0x12, 0x1c,
+	0x0a, 0x03, 0x70, 0x6f, 0x77, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x77, 0x48, 0x00, 0x52, 0x03, 0x50, 0x6f, 0x77, 0x12, 0x26, 0x0a, 0x07,
+	0x6c, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x48, 0x00, 0x52, 0x06, 0x4c, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18,
+	0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x52, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x48, 0x00, 0x52, 0x06, 0x52, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x23, 0x0a, 0x06,
+	0x62, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x42, 0x69, 0x74, 0x4f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x42, 0x69, 0x74, 0x4f,
+	0x72, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x5f, 0x78, 0x6f, 0x72, 0x18, 0x38, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x42, 0x69, 0x74, 0x58, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x06, 0x42, 0x69, 0x74, 0x58, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x69, 0x74,
+	0x5f, 0x61, 0x6e, 0x64, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x42, 0x69, 0x74, 0x41, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x06, 0x42, 0x69, 0x74, 0x41, 0x6e,
+	0x64, 0x12, 0x2c, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x76, 0x18, 0x3a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x46, 0x6c, 0x6f, 0x6f, 0x72,
+	0x44, 0x69, 0x76, 0x48, 0x00, 0x52, 0x08, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x44, 0x69, 0x76, 0x12,
+	0x1c, 0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x41, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x03, 0x41, 0x6e, 0x64, 0x12, 0x19, 0x0a,
+	0x02, 0x6f, 0x72, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x73, 0x74, 0x2e,
+	0x4f, 0x72, 0x48, 0x00, 0x52, 0x02, 0x4f, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52, 0x06, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x03, 0x4e, 0x6f, 0x74, 0x12, 0x20, 0x0a,
+	0x05, 0x75, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x55, 0x41, 0x64, 0x64, 0x48, 0x00, 0x52, 0x04, 0x55, 0x41, 0x64, 0x64, 0x12,
+	0x20, 0x0a, 0x05, 0x75, 0x5f, 0x73, 0x75, 0x62, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x55, 0x53, 0x75, 0x62, 0x48, 0x00, 0x52, 0x04, 0x55, 0x53, 0x75,
+	0x62, 0x12, 0x19, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x45, 0x71, 0x48, 0x00, 0x52, 0x02, 0x45, 0x71, 0x12, 0x23, 0x0a, 0x06,
+	0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x71, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x45, 0x71, 0x48, 0x00, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x45,
+	0x71, 0x12, 0x19, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x43, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4c, 0x74, 0x48, 0x00, 0x52, 0x02, 0x4c, 0x74, 0x12, 0x1d, 0x0a, 0x04,
+	0x6c, 0x74, 0x5f, 0x65, 0x18, 0x44, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x4c, 0x74, 0x45, 0x48, 0x00, 0x52, 0x03, 0x4c, 0x74, 0x45, 0x12, 0x19, 0x0a, 0x02, 0x67,
+	0x74, 0x18, 0x45, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x47, 0x74,
+	0x48, 0x00, 0x52, 0x02, 0x47, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x67, 0x74, 0x5f, 0x65, 0x18, 0x46,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x47, 0x74, 0x45, 0x48, 0x00,
+	0x52, 0x03, 0x47, 0x74, 0x45, 0x12, 0x23, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x18,
+	0x47, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x73, 0x4e, 0x6f,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x49, 0x73, 0x4e, 0x6f, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x6e,
+	0x18, 0x48, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x48,
+	0x00, 0x52, 0x02, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18,
+	0x49, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x49,
+	0x6e, 0x48, 0x00, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x22, 0x0a, 0x05, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x73, 0x74, 0x2e,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x2b,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x22, 0x33, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x05, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x22,
+	0x05, 0x0a, 0x03, 0x41, 0x6e, 0x64, 0x22, 0x28, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x69, 0x74, 0x12,
+	0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x40, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x74,
+	0x74, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x09, 0x41, 0x6e, 0x6e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x12, 0x21, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x0a, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x0a, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x42, 0x0a, 0x03, 0x41, 0x72, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x72, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x72, 0x67, 0x12, 0x29, 0x0a, 0x0a, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x02, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x67, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x12, 0x2a, 0x0a, 0x0c, 0x6b, 0x77, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x61, 0x72, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x67,
+	0x52, 0x0a, 0x6b, 0x77, 0x6f, 0x6e, 0x6c, 0x79, 0x61, 0x72, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x0d,
+	0x70, 0x6f, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x67, 0x52, 0x0b, 0x70,
+	0x6f, 0x73, 0x6f, 0x6e, 0x6c, 0x79, 0x61, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x06, 0x76, 0x61,
+	0x72, 0x61, 0x72, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x41, 0x72, 0x67, 0x52, 0x06, 0x76, 0x61, 0x72, 0x61, 0x72, 0x67, 0x12, 0x1e, 0x0a, 0x05,
+	0x6b, 0x77, 0x61, 0x72, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x41, 0x72, 0x67, 0x52, 0x05, 0x6b, 0x77, 0x61, 0x72, 0x67, 0x12, 0x25, 0x0a, 0x08,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x0b, 0x6b, 0x77, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6b, 0x77, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x6b, 0x0a, 0x08, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x1d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xc1, 0x01,
+	0x0a, 0x10, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x07, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x31,
+	0x0a, 0x0e, 0x64, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0x4f, 0x0a, 0x09, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x57, 0x69, 0x74, 0x68, 0x12, 0x23,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x22, 0x68, 0x0a, 0x06, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x23, 0x0a, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x05,
+	0x42, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x1d, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6c, 0x65, 0x66, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x02, 0x6f, 0x70, 0x12,
+	0x1f, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x08, 0x0a, 0x06, 0x42, 0x69, 0x74, 0x41, 0x6e, 0x64, 0x22, 0x07, 0x0a, 0x05, 0x42, 0x69,
+	0x74, 0x4f, 0x72, 0x22, 0x08, 0x0a, 0x06, 0x42, 0x69, 0x74, 0x58, 0x6f, 0x72, 0x22, 0x46, 0x0a,
+	0x06, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x12, 0x19, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x02,
+	0x6f, 0x70, 0x12, 0x21, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x6e,
+	0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x66, 0x75, 0x6e, 0x63, 0x12, 0x1d, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xb8,
+	0x01, 0x0a, 0x08, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x31, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x6f, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x6f, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x72, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6c, 0x65,
+	0x66, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x6f, 0x70, 0x73, 0x12,
+	0x2b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x8a, 0x01, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x03, 0x69, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x69, 0x66, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x73, 0x5f, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x69, 0x73, 0x5f, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x88, 0x01, 0x0a, 0x08, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x03, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x65, 0x6c, 0x6c, 0x69, 0x70,
+	0x73, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6c, 0x6c,
+	0x69, 0x70, 0x73, 0x69, 0x73, 0x12, 0x14, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x0a, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65,
+	0x22, 0x48, 0x0a, 0x04, 0x44, 0x69, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x05, 0x0a, 0x03, 0x44, 0x69,
+	0x76, 0x22, 0x04, 0x0a, 0x02, 0x45, 0x71, 0x22, 0x61, 0x0a, 0x0d, 0x45, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x27, 0x0a, 0x04, 0x45, 0x78,
+	0x70, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x66, 0x0a, 0x03, 0x46, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1d, 0x0a,
+	0x04, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x0a, 0x0a, 0x08, 0x46,
+	0x6c, 0x6f, 0x6f, 0x72, 0x44, 0x69, 0x76, 0x22, 0xbc, 0x01, 0x0a, 0x0b, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x41,
+	0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e,
+	0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
+	0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x23,
+	0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x1e, 0x0a, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x04, 0x0a, 0x02, 0x47, 0x74, 0x22, 0x05, 0x0a, 0x03,
+	0x47, 0x74, 0x45, 0x22, 0x66, 0x0a, 0x02, 0x49, 0x66, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x22, 0x0a, 0x07, 0x6f, 0x72, 0x5f, 0x65, 0x6c,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x06, 0x6f, 0x72, 0x65, 0x6c, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x05, 0x49,
+	0x66, 0x45, 0x78, 0x70, 0x12, 0x1d, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x74,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x22, 0x0a, 0x07, 0x6f, 0x72, 0x5f, 0x65, 0x6c, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x06,
+	0x6f, 0x72, 0x65, 0x6c, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x5b, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x32,
+	0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a,
+	0x07, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x22, 0x04, 0x0a, 0x02, 0x49, 0x6e, 0x22, 0x08, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x22, 0x04, 0x0a, 0x02, 0x49, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x49, 0x73, 0x4e, 0x6f,
+	0x74, 0x22, 0x3c, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x72, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x72, 0x67, 0x12, 0x1f,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x4b, 0x0a, 0x06, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x12, 0x22, 0x0a, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x41, 0x72,
+	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x25, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x65, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x65,
+	0x6c, 0x74, 0x73, 0x22, 0x5b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x12,
+	0x1b, 0x0a, 0x03, 0x65, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x65, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x0a,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x22, 0x08, 0x0a, 0x06, 0x4c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x22, 0x04, 0x0a, 0x02, 0x4c, 0x74,
+	0x22, 0x05, 0x0a, 0x03, 0x4c, 0x74, 0x45, 0x22, 0x09, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x4d, 0x75,
+	0x6c, 0x74, 0x22, 0x05, 0x0a, 0x03, 0x4d, 0x6f, 0x64, 0x22, 0x27, 0x0a, 0x06, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x22, 0x06, 0x0a, 0x04, 0x4d, 0x75, 0x6c, 0x74, 0x22, 0x16, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x05, 0x0a, 0x03, 0x4e, 0x6f, 0x74, 0x22, 0x07, 0x0a, 0x05, 0x4e, 0x6f, 0x74,
+	0x45, 0x71, 0x22, 0x07, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x22, 0x04, 0x0a, 0x02, 0x4f,
+	0x72, 0x22, 0x06, 0x0a, 0x04, 0x50, 0x61, 0x73, 0x73, 0x22, 0x05, 0x0a, 0x03, 0x50, 0x6f, 0x77,
+	0x22, 0x45, 0x0a, 0x05, 0x52, 0x61, 0x69, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x65, 0x78, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x03, 0x65, 0x78, 0x63, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x06, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x08, 0x0a, 0x06, 0x52, 0x53, 0x68, 0x69, 0x66, 0x74, 0x22, 0x2a, 0x0a, 0x07,
+	0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x05, 0x0a, 0x03, 0x53, 0x75, 0x62, 0x22,
+	0x4d, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x05, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x22, 0xa2,
+	0x01, 0x0a, 0x03, 0x54, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78,
+	0x63, 0x65, 0x70, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x07, 0x6f, 0x72, 0x5f, 0x65, 0x6c, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x06, 0x6f, 0x72, 0x65, 0x6c, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0a, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0x26, 0x0a, 0x05, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x04,
+	0x65, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x65, 0x6c, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x09, 0x54,
+	0x79, 0x70, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x06, 0x0a, 0x04, 0x55, 0x41, 0x64, 0x64,
+	0x22, 0x49, 0x0a, 0x07, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x4f, 0x70, 0x12, 0x19, 0x0a, 0x02, 0x6f,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x22, 0x06, 0x0a, 0x04, 0x55,
+	0x53, 0x75, 0x62, 0x22, 0x4a, 0x0a, 0x04, 0x57, 0x69, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74,
+	0x2e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
+	0x6a, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2d, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x12, 0x2f, 0x0a, 0x0d, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0d, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x22, 0x28, 0x0a, 0x05, 0x59,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x71, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x73, 0x74,
+	0x42, 0x08, 0x41, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x71, 0x6c, 0x63, 0x2d, 0x64, 0x65,
+	0x76, 0x2f, 0x73, 0x71, 0x6c, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x70, 0x79, 0x74, 0x68, 0x6f,
+	0x6e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x73, 0x74, 0xa2, 0x02,
+	0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x03, 0x41, 0x73, 0x74, 0xca, 0x02, 0x03, 0x41, 0x73, 0x74,
+	0xe2, 0x02, 0x0f, 0x41, 0x73, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x03, 0x41, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ast_ast_proto_rawDescOnce sync.Once
+	file_ast_ast_proto_rawDescData = file_ast_ast_proto_rawDesc
+)
+
+func file_ast_ast_proto_rawDescGZIP() []byte {
+	file_ast_ast_proto_rawDescOnce.Do(func() {
+		file_ast_ast_proto_rawDescData = protoimpl.X.CompressGZIP(file_ast_ast_proto_rawDescData)
+	})
+	return file_ast_ast_proto_rawDescData
+}
+
+var file_ast_ast_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_ast_ast_proto_goTypes = []interface{}{
+	(*Node)(nil),             // 0: ast.Node
+	(*Alias)(nil),            // 1: ast.Alias
+	(*Add)(nil),              // 2: ast.Add
+	(*And)(nil),              // 3: ast.And
+	(*Await)(nil),            // 4: ast.Await
+	(*Attribute)(nil),        // 5: ast.Attribute
+	(*AnnAssign)(nil),        // 6: ast.AnnAssign
+	(*Arg)(nil),              // 7: ast.Arg
+	(*Arguments)(nil),        // 8: ast.Arguments
+	(*AsyncFor)(nil),         // 9: ast.AsyncFor
+	(*AsyncFunctionDef)(nil), // 10: ast.AsyncFunctionDef
+	(*AsyncWith)(nil),        // 11: ast.AsyncWith
+	(*Assign)(nil),           // 12: ast.Assign
+	(*BinOp)(nil),            // 13: ast.BinOp
+	(*BitAnd)(nil),           // 14: ast.BitAnd
+	(*BitOr)(nil),            // 15: ast.BitOr
+	(*BitXor)(nil),           // 16: ast.BitXor
+	(*BoolOp)(nil),           // 17: ast.BoolOp
+	(*Break)(nil),            // 18: ast.Break
+	(*Call)(nil),             // 19: ast.Call
+	(*ClassDef)(nil),         // 20: ast.ClassDef
+	(*Comment)(nil),          // 21: ast.Comment
+	(*Compare)(nil),          // 22: ast.Compare
+	(*Comprehension)(nil),    // 23: ast.Comprehension
+	(*Constant)(nil),         // 24: ast.Constant
+	(*Continue)(nil),         // 25: ast.Continue
+	(*Dict)(nil),             // 26: ast.Dict
+	(*Div)(nil),              // 27: ast.Div
+	(*Eq)(nil),               // 28: ast.Eq
+	(*ExceptHandler)(nil),    // 29: ast.ExceptHandler
+	(*Expr)(nil),             // 30: ast.Expr
+	(*For)(nil),              // 31: ast.For
+	(*FloorDiv)(nil),         // 32: ast.FloorDiv
+	(*FunctionDef)(nil),      // 33: ast.FunctionDef
+	(*Global)(nil),           // 34: ast.Global
+	(*Gt)(nil),               // 35: ast.Gt
+	(*GtE)(nil),              // 36: ast.GtE
+	(*If)(nil),               // 37: ast.If
+	(*IfExp)(nil),            // 38: ast.IfExp
+	(*Import)(nil),           // 39: ast.Import
+	(*ImportFrom)(nil),       // 40: ast.ImportFrom
+	(*ImportGroup)(nil),      // 41: ast.ImportGroup
+	(*In)(nil),               // 42: ast.In
+	(*Invert)(nil),           // 43: ast.Invert
+	(*Is)(nil),               // 44: ast.Is
+	(*IsNot)(nil),            // 45: ast.IsNot
+	(*Keyword)(nil),          // 46: ast.Keyword
+	(*Lambda)(nil),           // 47: ast.Lambda
+	(*List)(nil),             // 48: ast.List
+	(*ListComp)(nil),         // 49: ast.ListComp
+	(*LShift)(nil),           // 50: ast.LShift
+	(*Lt)(nil),               // 51: ast.Lt
+	(*LtE)(nil),              // 52: ast.LtE
+	(*MatMult)(nil),          // 53: ast.MatMult
+	(*Mod)(nil),              // 54: ast.Mod
+	(*Module)(nil),           // 55: ast.Module
+	(*Mult)(nil),             // 56: ast.Mult
+	(*Name)(nil),             // 57: ast.Name
+	(*Not)(nil),              // 58: ast.Not
+	(*NotEq)(nil),            // 59: ast.NotEq
+	(*NotIn)(nil),            // 60: ast.NotIn
+	(*Or)(nil),               // 61: ast.Or
+	(*Pass)(nil),             // 62: ast.Pass
+	(*Pow)(nil),              // 63: ast.Pow
+	(*Raise)(nil),            // 64: ast.Raise
+	(*Return)(nil),           // 65: ast.Return
+	(*RShift)(nil),           // 66: ast.RShift
+	(*Starred)(nil),          // 67: ast.Starred
+	(*Sub)(nil),              // 68: ast.Sub
+	(*Subscript)(nil),        // 69: ast.Subscript
+	(*Try)(nil),              // 70: ast.Try
+	(*Tuple)(nil),            // 71: ast.Tuple
+	(*TypeAlias)(nil),        // 72: ast.TypeAlias
+	(*UAdd)(nil),             // 73: ast.UAdd
+	(*UnaryOp)(nil),          // 74: ast.UnaryOp
+	(*USub)(nil),             // 75: ast.USub
+	(*With)(nil),             // 76: ast.With
+	(*WithItem)(nil),         // 77: ast.WithItem
+	(*Yield)(nil),            // 78: ast.Yield
+}
+var file_ast_ast_proto_depIdxs = []int32{
+	20,  // 0: ast.Node.class_def:type_name -> ast.ClassDef
+	39,  // 1: ast.Node.import:type_name -> ast.Import
+	40,  // 2: ast.Node.import_from:type_name -> ast.ImportFrom
+	55,  // 3: ast.Node.module:type_name -> ast.Module
+	1,   // 4: ast.Node.alias:type_name -> ast.Alias
+	6,   // 5: ast.Node.ann_assign:type_name -> ast.AnnAssign
+	57,  // 6: ast.Node.name:type_name -> ast.Name
+	69,  // 7: ast.Node.subscript:type_name -> ast.Subscript
+	5,   // 8: ast.Node.attribute:type_name -> ast.Attribute
+	24,  // 9: ast.Node.constant:type_name -> ast.Constant
+	12,  // 10: ast.Node.assign:type_name -> ast.Assign
+	21,  // 11: ast.Node.comment:type_name -> ast.Comment
+	30,  // 12: ast.Node.expr:type_name -> ast.Expr
+	19,  // 13: ast.Node.call:type_name -> ast.Call
+	33,  // 14: ast.Node.function_def:type_name -> ast.FunctionDef
+	7,   // 15: ast.Node.arg:type_name -> ast.Arg
+	8,   // 16: ast.Node.arguments:type_name -> ast.Arguments
+	10,  // 17: ast.Node.async_function_def:type_name -> ast.AsyncFunctionDef
+	62,  // 18: ast.Node.pass:type_name -> ast.Pass
+	26,  // 19: ast.Node.dict:type_name -> ast.Dict
+	37,  // 20: ast.Node.if:type_name -> ast.If
+	22,  // 21: ast.Node.compare:type_name -> ast.Compare
+	65,  // 22: ast.Node.return:type_name -> ast.Return
+	44,  // 23: ast.Node.is:type_name -> ast.Is
+	46,  // 24: ast.Node.keyword:type_name -> ast.Keyword
+	78,  // 25: ast.Node.yield:type_name -> ast.Yield
+	31,  // 26: ast.Node.for:type_name -> ast.For
+	4,   // 27: ast.Node.await:type_name -> ast.Await
+	9,   // 28: ast.Node.async_for:type_name -> ast.AsyncFor
+	41,  // 29: ast.Node.import_group:type_name -> ast.ImportGroup
+	48,  // 30: ast.Node.list:type_name -> ast.List
+	71,  // 31: ast.Node.tuple:type_name -> ast.Tuple
+	13,  // 32: ast.Node.bin_op:type_name -> ast.BinOp
+	17,  // 33: ast.Node.bool_op:type_name -> ast.BoolOp
+	74,  // 34: ast.Node.unary_op:type_name -> ast.UnaryOp
+	47,  // 35: ast.Node.lambda:type_name -> ast.Lambda
+	38,  // 36: ast.Node.if_exp:type_name -> ast.IfExp
+	67,  // 37: ast.Node.starred:type_name -> ast.Starred
+	49,  // 38: ast.Node.list_comp:type_name -> ast.ListComp
+	64,  // 39: ast.Node.raise:type_name -> ast.Raise
+	70,  // 40: ast.Node.try:type_name -> ast.Try
+	76,  // 41: ast.Node.with:type_name -> ast.With
+	11,  // 42: ast.Node.async_with:type_name -> ast.AsyncWith
+	34,  // 43: ast.Node.global:type_name -> ast.Global
+	72,  // 44: ast.Node.type_alias:type_name -> ast.TypeAlias
+	2,   // 45: ast.Node.add:type_name -> ast.Add
+	68,  // 46: ast.Node.sub:type_name -> ast.Sub
+	56,  // 47: ast.Node.mult:type_name -> ast.Mult
+	53,  // 48: ast.Node.mat_mult:type_name -> ast.MatMult
+	27,  // 49: ast.Node.div:type_name -> ast.Div
+	54,  // 50: ast.Node.mod:type_name -> ast.Mod
+	63,  // 51: ast.Node.pow:type_name -> ast.Pow
+	50,  // 52: ast.Node.l_shift:type_name -> ast.LShift
+	66,  // 53: ast.Node.r_shift:type_name -> ast.RShift
+	15,  // 54: ast.Node.bit_or:type_name -> ast.BitOr
+	16,  // 55: ast.Node.bit_xor:type_name -> ast.BitXor
+	14,  // 56: ast.Node.bit_and:type_name -> ast.BitAnd
+	32,  // 57: ast.Node.floor_div:type_name -> ast.FloorDiv
+	3,   // 58: ast.Node.and:type_name -> ast.And
+	61,  // 59: ast.Node.or:type_name -> ast.Or
+	43,  // 60: ast.Node.invert:type_name -> ast.Invert
+	58,  // 61: ast.Node.not:type_name -> ast.Not
+	73,  // 62: ast.Node.u_add:type_name -> ast.UAdd
+	75,  // 63: ast.Node.u_sub:type_name -> ast.USub
+	28,  // 64: ast.Node.eq:type_name -> ast.Eq
+	59,  // 65: ast.Node.not_eq:type_name -> ast.NotEq
+	51,  // 66: ast.Node.lt:type_name -> ast.Lt
+	52,  // 67: ast.Node.lt_e:type_name -> ast.LtE
+	35,  // 68: ast.Node.gt:type_name -> ast.Gt
+	36,  // 69: ast.Node.gt_e:type_name -> ast.GtE
+	45,  // 70: ast.Node.is_not:type_name -> ast.IsNot
+	42,  // 71: ast.Node.in:type_name -> ast.In
+	60,  // 72: ast.Node.not_in:type_name -> ast.NotIn
+	18,  // 73: ast.Node.break:type_name -> ast.Break
+	25,  // 74: ast.Node.continue:type_name -> ast.Continue
+	0,   // 75: ast.Await.value:type_name -> ast.Node
+	0,   // 76: ast.Attribute.value:type_name -> ast.Node
+	57,  // 77: ast.AnnAssign.target:type_name -> ast.Name
+	0,   // 78: ast.AnnAssign.annotation:type_name -> ast.Node
+	0,   // 79: ast.Arg.annotation:type_name -> ast.Node
+	7,   // 80: ast.Arguments.args:type_name -> ast.Arg
+	7,   // 81: ast.Arguments.kw_only_args:type_name -> ast.Arg
+	7,   // 82: ast.Arguments.pos_only_args:type_name -> ast.Arg
+	7,   // 83: ast.Arguments.vararg:type_name -> ast.Arg
+	7,   // 84: ast.Arguments.kwarg:type_name -> ast.Arg
+	0,   // 85: ast.Arguments.defaults:type_name -> ast.Node
+	0,   // 86: ast.Arguments.kw_defaults:type_name -> ast.Node
+	0,   // 87: ast.AsyncFor.target:type_name -> ast.Node
+	0,   // 88: ast.AsyncFor.iter:type_name -> ast.Node
+	0,   // 89: ast.AsyncFor.body:type_name -> ast.Node
+	8,   // 90: ast.AsyncFunctionDef.Args:type_name -> ast.Arguments
+	0,   // 91: ast.AsyncFunctionDef.body:type_name -> ast.Node
+	0,   // 92: ast.AsyncFunctionDef.returns:type_name -> ast.Node
+	0,   // 93: ast.AsyncFunctionDef.decorator_list:type_name -> ast.Node
+	77,  // 94: ast.AsyncWith.items:type_name -> ast.WithItem
+	0,   // 95: ast.AsyncWith.body:type_name -> ast.Node
+	0,   // 96: ast.Assign.targets:type_name -> ast.Node
+	0,   // 97: ast.Assign.value:type_name -> ast.Node
+	0,   // 98: ast.BinOp.left:type_name -> ast.Node
+	0,   // 99: ast.BinOp.op:type_name -> ast.Node
+	0,   // 100: ast.BinOp.right:type_name -> ast.Node
+	0,   // 101: ast.BoolOp.op:type_name -> ast.Node
+	0,   // 102: ast.BoolOp.values:type_name -> ast.Node
+	0,   // 103: ast.Call.func:type_name -> ast.Node
+	0,   // 104: ast.Call.args:type_name -> ast.Node
+	46,  // 105: ast.Call.keywords:type_name -> ast.Keyword
+	0,   // 106: ast.ClassDef.bases:type_name -> ast.Node
+	0,   // 107: ast.ClassDef.keywords:type_name -> ast.Node
+	0,   // 108: ast.ClassDef.body:type_name -> ast.Node
+	0,   // 109: ast.ClassDef.decorator_list:type_name -> ast.Node
+	0,   // 110: ast.Compare.left:type_name -> ast.Node
+	0,   // 111: ast.Compare.ops:type_name -> ast.Node
+	0,   // 112: ast.Compare.comparators:type_name -> ast.Node
+	0,   // 113: ast.Comprehension.target:type_name -> ast.Node
+	0,   // 114: ast.Comprehension.iter:type_name -> ast.Node
+	0,   // 115: ast.Comprehension.ifs:type_name -> ast.Node
+	0,   // 116: ast.Dict.keys:type_name -> ast.Node
+	0,   // 117: ast.Dict.values:type_name -> ast.Node
+	0,   // 118: ast.ExceptHandler.type:type_name -> ast.Node
+	0,   // 119: ast.ExceptHandler.body:type_name -> ast.Node
+	0,   // 120: ast.Expr.value:type_name -> ast.Node
+	0,   // 121: ast.For.target:type_name -> ast.Node
+	0,   // 122: ast.For.iter:type_name -> ast.Node
+	0,   // 123: ast.For.body:type_name -> ast.Node
+	8,   // 124: ast.FunctionDef.Args:type_name -> ast.Arguments
+	0,   // 125: ast.FunctionDef.body:type_name -> ast.Node
+	0,   // 126: ast.FunctionDef.returns:type_name -> ast.Node
+	0,   // 127: ast.FunctionDef.decorator_list:type_name -> ast.Node
+	0,   // 128: ast.If.test:type_name -> ast.Node
+	0,   // 129: ast.If.body:type_name -> ast.Node
+	0,   // 130: ast.If.or_else:type_name -> ast.Node
+	0,   // 131: ast.IfExp.test:type_name -> ast.Node
+	0,   // 132: ast.IfExp.body:type_name -> ast.Node
+	0,   // 133: ast.IfExp.or_else:type_name -> ast.Node
+	0,   // 134: ast.Import.names:type_name -> ast.Node
+	0,   // 135: ast.ImportFrom.names:type_name -> ast.Node
+	0,   // 136: ast.ImportGroup.imports:type_name -> ast.Node
+	0,   // 137: ast.Keyword.value:type_name -> ast.Node
+	8,   // 138: ast.Lambda.args:type_name -> ast.Arguments
+	0,   // 139: ast.Lambda.body:type_name -> ast.Node
+	0,   // 140: ast.List.elts:type_name -> ast.Node
+	0,   // 141: ast.ListComp.elt:type_name -> ast.Node
+	23,  // 142: ast.ListComp.generators:type_name -> ast.Comprehension
+	0,   // 143: ast.Module.body:type_name -> ast.Node
+	0,   // 144: ast.Raise.exc:type_name -> ast.Node
+	0,   // 145: ast.Raise.cause:type_name -> ast.Node
+	0,   // 146: ast.Return.value:type_name -> ast.Node
+	0,   // 147: ast.Starred.value:type_name -> ast.Node
+	0,   // 148: ast.Subscript.value:type_name -> ast.Node
+	0,   // 149: ast.Subscript.slice:type_name -> ast.Node
+	0,   // 150: ast.Try.body:type_name -> ast.Node
+	29,  // 151: ast.Try.handlers:type_name -> ast.ExceptHandler
+	0,   // 152: ast.Try.or_else:type_name -> ast.Node
+	0,   // 153: ast.Try.final_body:type_name -> ast.Node
+	0,   // 154: ast.Tuple.elts:type_name -> ast.Node
+	57,  // 155: ast.TypeAlias.name:type_name -> ast.Name
+	0,   // 156: ast.TypeAlias.value:type_name -> ast.Node
+	0,   // 157: ast.UnaryOp.op:type_name -> ast.Node
+	0,   // 158: ast.UnaryOp.operand:type_name -> ast.Node
+	77,  // 159: ast.With.items:type_name -> ast.WithItem
+	0,   // 160: ast.With.body:type_name -> ast.Node
+	0,   // 161: ast.WithItem.context_expr:type_name -> ast.Node
+	0,   // 162: ast.WithItem.optional_vars:type_name -> ast.Node
+	0,   // 163: ast.Yield.value:type_name -> ast.Node
+	164, // [164:164] is the sub-list for method output_type
+	164, // [164:164] is the sub-list for method input_type
+	164, // [164:164] is the sub-list for extension type_name
+	164, // [164:164] is the sub-list for extension extendee
+	0,   // [0:164] is the sub-list for field type_name
+}
+
+func init() { file_ast_ast_proto_init() }
+func file_ast_ast_proto_init() {
+	if File_ast_ast_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ast_ast_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Add); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*And); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Await); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnAssign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Arg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Arguments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncFor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncFunctionDef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncWith); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BitAnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BitOr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BitXor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoolOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Break); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Call); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassDef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comprehension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Constant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Continue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Dict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Div); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Eq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExceptHandler); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2594,8 +6190,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Alias); i {
+		file_ast_ast_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Expr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2606,8 +6202,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Await); i {
+		file_ast_ast_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*For); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2618,8 +6214,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Attribute); i {
+		file_ast_ast_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloorDiv); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2630,8 +6226,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnnAssign); i {
+		file_ast_ast_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FunctionDef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2642,8 +6238,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Arg); i {
+		file_ast_ast_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Global); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2654,8 +6250,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Arguments); i {
+		file_ast_ast_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2666,8 +6262,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AsyncFor); i {
+		file_ast_ast_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GtE); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2678,8 +6274,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AsyncFunctionDef); i {
+		file_ast_ast_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*If); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2690,8 +6286,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assign); i {
+		file_ast_ast_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IfExp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2702,8 +6298,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Call); i {
+		file_ast_ast_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Import); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2714,8 +6310,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClassDef); i {
+		file_ast_ast_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFrom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2726,8 +6322,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
+		file_ast_ast_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2738,8 +6334,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Compare); i {
+		file_ast_ast_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*In); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2750,8 +6346,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Constant); i {
+		file_ast_ast_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2762,8 +6358,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Dict); i {
+		file_ast_ast_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Is); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2774,8 +6370,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Expr); i {
+		file_ast_ast_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsNot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2786,8 +6382,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*For); i {
+		file_ast_ast_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Keyword); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2798,8 +6394,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FunctionDef); i {
+		file_ast_ast_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lambda); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2810,8 +6406,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*If); i {
+		file_ast_ast_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2822,8 +6418,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Import); i {
+		file_ast_ast_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListComp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2834,8 +6430,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportFrom); i {
+		file_ast_ast_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LShift); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2846,8 +6442,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportGroup); i {
+		file_ast_ast_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2858,8 +6454,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Is); i {
+		file_ast_ast_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LtE); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2870,8 +6466,8 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Keyword); i {
+		file_ast_ast_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatMult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2882,7 +6478,19 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_ast_ast_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Module); i {
 			case 0:
 				return &v.state
@@ -2894,7 +6502,19 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_ast_ast_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Name); i {
 			case 0:
 				return &v.state
@@ -2906,7 +6526,55 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_ast_ast_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Not); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotEq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Or); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Pass); i {
 			case 0:
 				return &v.state
@@ -2918,7 +6586,31 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_ast_ast_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Raise); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Return); i {
 			case 0:
 				return &v.state
@@ -2930,7 +6622,43 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_ast_ast_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Starred); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sub); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Subscript); i {
 			case 0:
 				return &v.state
@@ -2942,7 +6670,103 @@ func file_ast_ast_proto_init() {
 				return nil
 			}
 		}
-		file_ast_ast_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_ast_ast_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Try); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeAlias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UAdd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnaryOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*USub); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*With); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ast_ast_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Yield); i {
 			case 0:
 				return &v.state
@@ -2986,11 +6810,58 @@ func file_ast_ast_proto_init() {
 		(*Node_Await)(nil),
 		(*Node_AsyncFor)(nil),
 		(*Node_ImportGroup)(nil),
-	}
-	file_ast_ast_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*Node_List)(nil),
+		(*Node_Tuple)(nil),
+		(*Node_BinOp)(nil),
+		(*Node_BoolOp)(nil),
+		(*Node_UnaryOp)(nil),
+		(*Node_Lambda)(nil),
+		(*Node_IfExp)(nil),
+		(*Node_Starred)(nil),
+		(*Node_ListComp)(nil),
+		(*Node_Raise)(nil),
+		(*Node_Try)(nil),
+		(*Node_With)(nil),
+		(*Node_AsyncWith)(nil),
+		(*Node_Global)(nil),
+		(*Node_TypeAlias)(nil),
+		(*Node_Add)(nil),
+		(*Node_Sub)(nil),
+		(*Node_Mult)(nil),
+		(*Node_MatMult)(nil),
+		(*Node_Div)(nil),
+		(*Node_Mod)(nil),
+		(*Node_Pow)(nil),
+		(*Node_LShift)(nil),
+		(*Node_RShift)(nil),
+		(*Node_BitOr)(nil),
+		(*Node_BitXor)(nil),
+		(*Node_BitAnd)(nil),
+		(*Node_FloorDiv)(nil),
+		(*Node_And)(nil),
+		(*Node_Or)(nil),
+		(*Node_Invert)(nil),
+		(*Node_Not)(nil),
+		(*Node_UAdd)(nil),
+		(*Node_USub)(nil),
+		(*Node_Eq)(nil),
+		(*Node_NotEq)(nil),
+		(*Node_Lt)(nil),
+		(*Node_LtE)(nil),
+		(*Node_Gt)(nil),
+		(*Node_GtE)(nil),
+		(*Node_IsNot)(nil),
+		(*Node_In)(nil),
+		(*Node_NotIn)(nil),
+		(*Node_Break)(nil),
+		(*Node_Continue)(nil),
+	}
+	file_ast_ast_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*Constant_Str)(nil),
 		(*Constant_Int)(nil),
 		(*Constant_None)(nil),
+		(*Constant_Ellipsis)(nil),
+		(*Constant_Bool)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2998,7 +6869,7 @@ func file_ast_ast_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ast_ast_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
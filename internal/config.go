@@ -1,13 +1,45 @@
 package python
 
 type Config struct {
-	EmitExactTableNames         bool     `json:"emit_exact_table_names"`
-	EmitSyncQuerier             bool     `json:"emit_sync_querier"`
-	EmitAsyncQuerier            bool     `json:"emit_async_querier"`
-	Package                     string   `json:"package"`
-	Out                         string   `json:"out"`
-	EmitPydanticModels          bool     `json:"emit_pydantic_models"`
-	EmitStrEnum                 bool     `json:"emit_str_enum"`
-	QueryParameterLimit         *int32   `json:"query_parameter_limit"`
-	InflectionExcludeTableNames []string `json:"inflection_exclude_table_names"`
+	EmitExactTableNames         bool                         `json:"emit_exact_table_names"`
+	EmitSyncQuerier             bool                         `json:"emit_sync_querier"`
+	EmitAsyncQuerier            bool                         `json:"emit_async_querier"`
+	Package                     string                       `json:"package"`
+	Out                         string                       `json:"out"`
+	EmitPydanticModels          bool                         `json:"emit_pydantic_models"`
+	EmitStrEnum                 bool                         `json:"emit_str_enum"`
+	QueryParameterLimit         *int32                       `json:"query_parameter_limit"`
+	InflectionExcludeTableNames []string                     `json:"inflection_exclude_table_names"`
+	EmitOptionalArrayElements   bool                         `json:"emit_optional_array_elements"`
+	DedupeStructs               bool                         `json:"dedupe_structs"`
+	SharedStructsModule         string                       `json:"shared_structs_module"`
+	ModelMatching               string                       `json:"model_matching"`
+	EmitModelProjections        bool                         `json:"emit_model_projections"`
+	EmitInit                    bool                         `json:"emit_init"`
+	EmitCombinedQuerier         bool                         `json:"emit_combined_querier"`
+	OutputModelsFileName        string                       `json:"output_models_file_name"`
+	OutputFilesPrefix           string                       `json:"output_files_prefix"`
+	OutputFilesSuffix           string                       `json:"output_files_suffix"`
+	SanitizeModuleNames         bool                         `json:"sanitize_module_names"`
+	QueryModules                map[string]string            `json:"query_modules"`
+	EmitStubs                   bool                         `json:"emit_stubs"`
+	EmitDocstrings              bool                         `json:"emit_docstrings"`
+	DocstringsIncludeSQL        bool                         `json:"docstrings_include_sql"`
+	ColumnComments              string                       `json:"column_comments"`
+	LineLength                  int                          `json:"line_length"`
+	SkipMagicTrailingComma      bool                         `json:"skip_magic_trailing_comma"`
+	RawStrings                  bool                         `json:"raw_strings"`
+	EmitNoRowsError             bool                         `json:"emit_no_rows_error"`
+	EmitTooManyRowsError        bool                         `json:"emit_too_many_rows_error"`
+	EmitManyAsList              bool                         `json:"emit_many_as_list"`
+	EmitTransactionHelpers      bool                         `json:"emit_transaction_helpers"`
+	EmitEngineConstructors      bool                         `json:"emit_engine_constructors"`
+	EmitQueryHooks              bool                         `json:"emit_query_hooks"`
+	ExecutionOptions            map[string]interface{}       `json:"execution_options"`
+	EmitStreamingMany           bool                         `json:"emit_streaming_many"`
+	StreamYieldPer              int                          `json:"stream_yield_per"`
+	RowAccess                   string                       `json:"row_access"`
+	RowConstructor              string                       `json:"row_constructor"`
+	EnumValueNames              map[string]map[string]string `json:"enum_value_names"`
+	Rename                      map[string]string            `json:"rename"`
 }
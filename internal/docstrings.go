@@ -0,0 +1,95 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// paramNames returns the names of the parameters of the generated method. The
+// fields of a params class are described instead of the class itself.
+func (q Query) paramNames() []string {
+	var names []string
+	for _, a := range q.Args {
+		if a.IsStruct() {
+			for _, f := range a.Struct.Fields {
+				names = append(names, f.Name)
+			}
+		} else {
+			names = append(names, a.Name)
+		}
+	}
+	return names
+}
+
+func (q Query) checkParamAnnotations() error {
+	names := map[string]bool{}
+	for _, name := range q.paramNames() {
+		names[name] = true
+	}
+	for name := range q.Annotations.Params {
+		if !names[name] {
+			return fmt.Errorf("@param: unknown parameter %q", name)
+		}
+	}
+	return nil
+}
+
+// Docstring returns the docstring of the query methods, made of the comments
+// above the query, the parameter descriptions and optionally the SQL.
+func (q Query) Docstring(conf Config) string {
+	var sections []string
+
+	var lines []string
+	for _, c := range q.Comments {
+		lines = append(lines, strings.TrimRight(strings.TrimPrefix(c, " "), " \t"))
+	}
+	summary := strings.TrimSpace(strings.Join(lines, "\n"))
+	if summary != "" {
+		sections = append(sections, summary)
+	}
+
+	var args []string
+	for _, name := range q.paramNames() {
+		if desc := q.Annotations.Params[name]; desc != "" {
+			args = append(args, fmt.Sprintf("    %s: %s", name, desc))
+		}
+	}
+	if len(args) > 0 {
+		sections = append(sections, "Args:\n"+strings.Join(args, "\n"))
+	}
+
+	if conf.DocstringsIncludeSQL {
+		var sql []string
+		for _, line := range strings.Split(strings.TrimSpace(q.Text), "\n") {
+			line = strings.TrimRight(line, " \t")
+			if line != "" {
+				line = "    " + line
+			}
+			sql = append(sql, line)
+		}
+		sections = append(sections, "SQL:\n"+strings.Join(sql, "\n"))
+	}
+
+	doc := strings.Join(sections, "\n\n")
+	if summary == "" && doc != "" {
+		// Sections start on the line after the opening quotes
+		doc = "\n" + doc
+	}
+	return doc
+}
+
+// docstringNode returns the first statement of the body of the query methods,
+// or nil if they have no docstring.
+func docstringNode(conf Config, q Query) *pyast.Node {
+	if !conf.EmitDocstrings {
+		return nil
+	}
+	doc := q.Docstring(conf)
+	if doc == "" {
+		return nil
+	}
+	return poet.Expr(poet.Constant(doc))
+}
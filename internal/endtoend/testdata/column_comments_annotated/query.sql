@@ -0,0 +1,7 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: ListAuthorNames :many
+SELECT name, bio FROM authors
+ORDER BY name;
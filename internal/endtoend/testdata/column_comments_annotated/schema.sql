@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL,
+  bio  text
+);
+
+COMMENT ON TABLE authors IS 'Authors of the books in the catalog';
+COMMENT ON COLUMN authors.name IS 'Full name of the author';
+COMMENT ON COLUMN authors.bio IS 'Short biography, if known';
@@ -0,0 +1,31 @@
+-- name: ListAuthorNames :many
+SELECT id, name FROM authors
+ORDER BY name;
+
+-- name: SearchAuthorNames :many
+SELECT id, name FROM authors
+WHERE name LIKE $1;
+
+-- @row_type AuthorName
+-- name: GetAuthorName :one
+SELECT id, name FROM authors
+WHERE id = $1;
+
+-- name: ListBookTitles :many
+SELECT books.id, books.title, books.status
+FROM books
+WHERE author_id = $1;
+
+-- name: ListAvailableBookTitles :many
+SELECT books.id, books.title, books.status
+FROM books
+WHERE status = 'available';
+
+-- @row_type AuthorLabel
+-- name: GetAuthorLabel :one
+SELECT id, name FROM authors
+WHERE name = $1;
+
+-- name: RenameAuthor :exec
+UPDATE authors SET name = $2
+WHERE id = $1;
@@ -0,0 +1,7 @@
+-- name: ListAuthorNames :many
+SELECT id, name FROM authors
+ORDER BY name;
+
+-- name: GetAuthorBio :one
+SELECT id, bio FROM authors
+WHERE id = $1;
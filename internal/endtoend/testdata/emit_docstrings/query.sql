@@ -0,0 +1,22 @@
+-- name: GetAuthor :one
+-- Get an author by id.
+-- @param id The id of the author
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: ListAuthors :many
+-- List all authors, sorted by name.
+--
+-- Authors without a biography are included.
+SELECT * FROM authors
+ORDER BY name::text;
+
+-- name: UpdateBookStatus :execrows
+-- @param id The id of the book
+-- @param status The new status of the book
+UPDATE books SET status = $2
+WHERE id = $1;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
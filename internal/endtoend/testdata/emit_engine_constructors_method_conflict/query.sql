@@ -0,0 +1,2 @@
+-- name: FromEngine :many
+SELECT * FROM authors;
@@ -0,0 +1,14 @@
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: ListAuthorNames :many
+SELECT name FROM authors
+WHERE bio IS NOT NULL
+ORDER BY name;
+
+-- Books can be numerous, so they are streamed
+-- @many iterator
+-- name: ListBooks :many
+SELECT * FROM books
+WHERE author_id = $1;
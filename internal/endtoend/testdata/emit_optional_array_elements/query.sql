@@ -0,0 +1,14 @@
+-- name: GetGrid :one
+SELECT * FROM grids
+WHERE id = $1 LIMIT 1;
+
+-- name: ListMatrices :many
+SELECT matrix FROM grids;
+
+-- name: FindByTags :many
+SELECT id, tags FROM grids
+WHERE tags && $1::text[];
+
+-- name: CreateGrid :exec
+INSERT INTO grids (tags, scores, matrix, cube)
+VALUES ($1, $2, $3, $4);
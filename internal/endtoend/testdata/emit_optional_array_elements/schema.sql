@@ -0,0 +1,7 @@
+CREATE TABLE grids (
+  id      BIGSERIAL PRIMARY KEY,
+  tags    text[]    NOT NULL,
+  scores  int[],
+  matrix  int[][]   NOT NULL,
+  cube    float[][][]
+);
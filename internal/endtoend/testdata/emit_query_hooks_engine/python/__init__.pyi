@@ -0,0 +1,22 @@
+# Code generated by sqlc. DO NOT EDIT.
+# versions:
+#   sqlc v1.28.0
+from querytest.authors import AsyncQuerier as AsyncAuthorsQuerier, Querier as AuthorsQuerier
+from querytest.books import AsyncQuerier as AsyncBooksQuerier, Querier as BooksQuerier
+from querytest.instrumentation import QueryHooks as QueryHooks
+from querytest.models import Author, Book, BookStatus
+from querytest.querier import AsyncQuerier as AsyncQuerier, Querier as Querier
+
+
+__all__ = [
+    "AsyncAuthorsQuerier",
+    "AsyncBooksQuerier",
+    "AsyncQuerier",
+    "Author",
+    "AuthorsQuerier",
+    "Book",
+    "BookStatus",
+    "BooksQuerier",
+    "Querier",
+    "QueryHooks",
+]
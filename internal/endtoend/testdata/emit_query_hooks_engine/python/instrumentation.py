@@ -0,0 +1,37 @@
+# Code generated by sqlc. DO NOT EDIT.
+# versions:
+#   sqlc v1.28.0
+import contextlib
+import time
+from typing import ContextManager, Iterator, Optional
+
+
+class QueryHooks:
+    """Callbacks run around each query of a querier.
+
+    Subclasses override before_query and after_query, or query to wrap
+    queries in a context manager such as an OpenTelemetry span.
+    """
+
+    def before_query(self, name: str, sql: str) -> None:
+        """Called before a query runs."""
+
+    def after_query(self, name: str, sql: str, duration: float) -> None:
+        """Called after a query ran or failed, with its duration in seconds."""
+
+    @contextlib.contextmanager
+    def query(self, name: str, sql: str) -> Iterator[None]:
+        """Runs around a query, calling before_query and after_query."""
+        self.before_query(name, sql)
+        start = time.perf_counter()
+        try:
+            yield
+        finally:
+            self.after_query(name, sql, time.perf_counter() - start)
+
+
+def run_query(hooks: Optional[QueryHooks], name: str, sql: str) -> ContextManager[None]:
+    """Returns the context manager run around a query."""
+    if hooks is None:
+        return contextlib.nullcontext()
+    return hooks.query(name, sql)
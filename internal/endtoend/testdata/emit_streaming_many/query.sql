@@ -0,0 +1,16 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- @execution_options yield_per=100
+-- name: ListBookTitles :many
+SELECT title FROM books
+WHERE author_id = $1;
+
+-- @many list
+-- name: ListBooks :many
+SELECT * FROM books;
@@ -0,0 +1,9 @@
+-- name: ListBookTitles :many
+SELECT books.id, books.title, books.status
+FROM books
+WHERE author_id = $1;
+
+-- name: ListAuthorsWithBooks :many
+SELECT DISTINCT authors.id, authors.name
+FROM authors
+JOIN books ON books.author_id = authors.id;
@@ -0,0 +1,3 @@
+-- name: GetPlayer :one
+SELECT * FROM players
+WHERE id = $1;
@@ -0,0 +1,11 @@
+CREATE TYPE rank AS ENUM ('1st', '2nd', 'a b', 'A+', 'A-', '+', '_internal_', 'class');
+
+CREATE SCHEMA library;
+
+CREATE TYPE library.shelf AS ENUM ('top-shelf', 'top_shelf');
+
+CREATE TABLE players (
+  id    BIGSERIAL     PRIMARY KEY,
+  rank  rank          NOT NULL,
+  shelf library.shelf NOT NULL
+);
@@ -0,0 +1,18 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1;
+
+-- @execution_options stream_results=true yield_per=500 statement_timeout=5min
+-- name: ListBooks :many
+SELECT * FROM books
+ORDER BY title;
+
+-- @execution_options statement_timeout=250 logging_token=cleanup compiled_cache=None
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
+
+-- @execution_options statement_timeout=1s
+-- name: DeleteBooksByAuthor :execresult
+DELETE FROM books
+WHERE author_id = $1;
@@ -0,0 +1,3 @@
+-- @execution_options isolation_level=SERIALIZABLE
+-- name: ListAuthors :many
+SELECT * FROM authors;
@@ -0,0 +1,3 @@
+-- @execution_options statement_timeout=5s
+-- name: Ping :one
+SELECT 1 AS one;
@@ -0,0 +1 @@
+-- statement_timeout is set with SET LOCAL, which only PostgreSQL has
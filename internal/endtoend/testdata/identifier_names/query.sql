@@ -0,0 +1,16 @@
+-- name: GetImport :one
+SELECT * FROM "import"
+WHERE "class" = $1 AND "from" = $2 AND "row" = $3;
+
+-- name: ListImportsByType :many
+-- @paginate keyset(class)
+SELECT * FROM "import"
+WHERE "type" = $1 AND "first name" = $2;
+
+-- name: Import :exec
+INSERT INTO "import" ("class", "from", "lambda", "type", "row", "first name", "2fa")
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetTypeByClass :one
+SELECT "type", "first name" FROM "import"
+WHERE "class" = $1;
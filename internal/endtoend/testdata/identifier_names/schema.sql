@@ -0,0 +1,11 @@
+CREATE TABLE "import" (
+  id         BIGSERIAL PRIMARY KEY,
+  "class"    text      NOT NULL,
+  "from"     text      NOT NULL,
+  "lambda"   text,
+  "type"     text      NOT NULL,
+  "row"      text      NOT NULL,
+  "first name" text    NOT NULL,
+  "2fa"      boolean   NOT NULL,
+  "spotify_url" text
+);
@@ -0,0 +1,16 @@
+-- name: GetBook :one
+SELECT * FROM books
+WHERE id = $1;
+
+-- name: CreateBook :one
+INSERT INTO books (isbn, title, subtitle, publisher_name, publication_year, status)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: UpdateBookTitle :execrows
+UPDATE books SET title = $2, subtitle = $3
+WHERE id = $1;
+
+-- name: ListBooksByPublisherAndYear :many
+SELECT id, isbn, title, publication_year FROM books
+WHERE publisher_name = $1 AND publication_year = $2 AND status = $3;
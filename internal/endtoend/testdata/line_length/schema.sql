@@ -0,0 +1,11 @@
+CREATE TYPE book_status AS ENUM ('available', 'checked_out');
+
+CREATE TABLE books (
+  id               BIGSERIAL   PRIMARY KEY,
+  isbn             text        NOT NULL,
+  title            text        NOT NULL,
+  subtitle         text,
+  publisher_name   text        NOT NULL,
+  publication_year integer     NOT NULL,
+  status           book_status NOT NULL
+);
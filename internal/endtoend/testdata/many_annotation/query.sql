@@ -0,0 +1,8 @@
+-- @many list
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: ListBooks :many
+SELECT * FROM books
+WHERE author_id = $1;
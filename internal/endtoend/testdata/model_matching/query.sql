@@ -0,0 +1,24 @@
+-- name: GetAuthor :one
+SELECT name, bio, id FROM authors
+WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT a.* FROM authors a
+ORDER BY a.name;
+
+-- name: ListAuthorNames :many
+SELECT id, name FROM authors
+ORDER BY name;
+
+-- name: SearchAuthorNames :many
+SELECT id, name FROM authors
+WHERE name LIKE $1;
+
+-- name: ListBookTitles :many
+SELECT title, status FROM books
+WHERE author_id = $1;
+
+-- name: ListAuthorsWithTitles :many
+SELECT authors.name, books.title
+FROM authors
+JOIN books ON books.author_id = authors.id;
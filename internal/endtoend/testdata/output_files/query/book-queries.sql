@@ -0,0 +1,4 @@
+-- name: ListBookTitles :many
+SELECT books.id, books.title, books.status
+FROM books
+WHERE author_id = $1;
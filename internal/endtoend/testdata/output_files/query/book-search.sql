@@ -0,0 +1,3 @@
+-- name: SearchBooks :many
+SELECT * FROM books
+WHERE title LIKE $1;
@@ -0,0 +1,14 @@
+-- @paginate keyset(id)
+-- name: ListAuthors :many
+SELECT * FROM authors;
+
+-- @paginate keyset(title)
+-- name: ListBookTitles :many
+SELECT title FROM books
+WHERE author_id = $1;
+
+-- @paginate offset
+-- name: ListBooksByStatus :many
+SELECT id, title FROM books
+WHERE status = $1
+ORDER BY title;
@@ -0,0 +1,146 @@
+# Code generated by sqlc. DO NOT EDIT.
+# versions:
+#   sqlc v1.28.0
+# source: query.sql
+import dataclasses
+import datetime
+from typing import Iterator, Optional
+
+import sqlalchemy
+
+from querytest import models
+
+
+LIST_PLAYER_DATES = """-- name: list_player_dates \\:many
+SELECT "from" FROM players
+"""
+
+
+LIST_PLAYER_DATES_PAGE = """-- name: list_player_dates_page \\:many
+SELECT * FROM (
+SELECT "from" FROM players
+) AS page
+WHERE :page_cursor IS NULL OR page."from" > :page_cursor
+ORDER BY page."from"
+LIMIT :page_limit
+"""
+
+
+LIST_PLAYERS_BY_FIRST_NAME = """-- name: list_players_by_first_name \\:many
+SELECT id, "first name", "Rank", "from" FROM players
+"""
+
+
+LIST_PLAYERS_BY_FIRST_NAME_PAGE = """-- name: list_players_by_first_name_page \\:many
+SELECT * FROM (
+SELECT id, "first name", "Rank", "from" FROM players
+) AS page
+WHERE :page_cursor IS NULL OR page."first name" > :page_cursor
+ORDER BY page."first name"
+LIMIT :page_limit
+"""
+
+
+LIST_PLAYERS_BY_RANK = """-- name: list_players_by_rank \\:many
+SELECT id, "Rank" FROM players
+"""
+
+
+LIST_PLAYERS_BY_RANK_PAGE = """-- name: list_players_by_rank_page \\:many
+SELECT * FROM (
+SELECT id, "Rank" FROM players
+) AS page
+WHERE :page_cursor IS NULL OR page."Rank" > :page_cursor
+ORDER BY page."Rank"
+LIMIT :page_limit
+"""
+
+
+@dataclasses.dataclass()
+class ListPlayersByRankRow:
+    id: int
+    Rank: int
+
+
+class Querier:
+    def __init__(self, conn: sqlalchemy.engine.Connection):
+        self._conn = conn
+
+    def list_player_dates(self) -> Iterator[datetime.date]:
+        result = self._conn.execute(sqlalchemy.text(LIST_PLAYER_DATES))
+        for row in result:
+            yield row[0]
+
+    def list_player_dates_page(self, *, page_size: int, cursor: Optional[datetime.date] = None) -> models.Page[datetime.date, datetime.date]:
+        if page_size < 1:
+            raise ValueError("page_size must be at least 1")
+        result = self._conn.execute(sqlalchemy.text(LIST_PLAYER_DATES_PAGE), {"page_cursor": cursor, "page_limit": page_size + 1})
+        items = [row[0] for row in result.fetchmany(page_size)]
+        next_cursor = None
+        if result.fetchone() is not None:
+            next_cursor = items[-1]
+        result.close()
+        return models.Page(
+            items=items,
+            next_cursor=next_cursor,
+        )
+
+    def list_players_by_first_name(self) -> Iterator[models.Player]:
+        result = self._conn.execute(sqlalchemy.text(LIST_PLAYERS_BY_FIRST_NAME))
+        for row in result:
+            yield models.Player(
+                id=row[0],
+                first_name=row[1],
+                Rank=row[2],
+                from_=row[3],
+            )
+
+    def list_players_by_first_name_page(self, *, page_size: int, cursor: Optional[str] = None) -> models.Page[models.Player, str]:
+        if page_size < 1:
+            raise ValueError("page_size must be at least 1")
+        result = self._conn.execute(sqlalchemy.text(LIST_PLAYERS_BY_FIRST_NAME_PAGE), {"page_cursor": cursor, "page_limit": page_size + 1})
+        items = [
+            models.Player(
+                id=row[0],
+                first_name=row[1],
+                Rank=row[2],
+                from_=row[3],
+            )
+            for row in result.fetchmany(page_size)
+        ]
+        next_cursor = None
+        if result.fetchone() is not None:
+            next_cursor = items[-1].first_name
+        result.close()
+        return models.Page(
+            items=items,
+            next_cursor=next_cursor,
+        )
+
+    def list_players_by_rank(self) -> Iterator[ListPlayersByRankRow]:
+        result = self._conn.execute(sqlalchemy.text(LIST_PLAYERS_BY_RANK))
+        for row in result:
+            yield ListPlayersByRankRow(
+                id=row[0],
+                Rank=row[1],
+            )
+
+    def list_players_by_rank_page(self, *, page_size: int, cursor: Optional[int] = None) -> models.Page[ListPlayersByRankRow, int]:
+        if page_size < 1:
+            raise ValueError("page_size must be at least 1")
+        result = self._conn.execute(sqlalchemy.text(LIST_PLAYERS_BY_RANK_PAGE), {"page_cursor": cursor, "page_limit": page_size + 1})
+        items = [
+            ListPlayersByRankRow(
+                id=row[0],
+                Rank=row[1],
+            )
+            for row in result.fetchmany(page_size)
+        ]
+        next_cursor = None
+        if result.fetchone() is not None:
+            next_cursor = items[-1].Rank
+        result.close()
+        return models.Page(
+            items=items,
+            next_cursor=next_cursor,
+        )
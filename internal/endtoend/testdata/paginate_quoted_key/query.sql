@@ -0,0 +1,11 @@
+-- @paginate keyset("first name")
+-- name: ListPlayersByFirstName :many
+SELECT * FROM players;
+
+-- @paginate keyset(Rank)
+-- name: ListPlayersByRank :many
+SELECT id, "Rank" FROM players;
+
+-- @paginate keyset(from)
+-- name: ListPlayerDates :many
+SELECT "from" FROM players;
@@ -0,0 +1,6 @@
+CREATE TABLE players (
+  id           BIGSERIAL PRIMARY KEY,
+  "first name" text      NOT NULL,
+  "Rank"       integer   NOT NULL,
+  "from"       date      NOT NULL
+);
@@ -0,0 +1,3 @@
+-- @paginate keyset(id)
+-- name: ListT :many
+SELECT * FROM t;
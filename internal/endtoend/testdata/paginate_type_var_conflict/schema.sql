@@ -0,0 +1,4 @@
+CREATE TABLE t (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL
+);
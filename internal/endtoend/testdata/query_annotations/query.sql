@@ -0,0 +1,25 @@
+-- @row_type AuthorSummary
+-- name: GetAuthorSummary :one
+SELECT id, name FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- @row_type AuthorSummary
+-- name: ListAuthorSummaries :many
+SELECT id, name FROM authors
+ORDER BY name;
+
+-- @row_type AuthorName
+-- name: ListAuthorNames :many
+SELECT name FROM authors
+ORDER BY name;
+
+-- Create an author.
+-- @params_type NewAuthor
+-- @row_type AuthorSummary
+-- name: CreateAuthor :one
+INSERT INTO authors (
+          name, bio
+) VALUES (
+  $1, $2
+)
+RETURNING id, name;
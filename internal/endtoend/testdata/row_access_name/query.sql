@@ -0,0 +1,19 @@
+-- name: GetAuthor :one
+SELECT name, bio, id FROM authors
+WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT a.* FROM authors a
+ORDER BY a.name;
+
+-- name: ListAuthorBooks :many
+SELECT authors.id, books.id, books.title
+FROM authors
+JOIN books ON books.author_id = authors.id;
+
+-- name: CountBooksByStatus :many
+SELECT status, count(*) FROM books
+GROUP BY status;
+
+-- name: ListBookTitles :many
+SELECT title FROM books;
@@ -0,0 +1,19 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: GetAuthorUnordered :one
+SELECT name, bio, id FROM authors
+WHERE id = $1;
+
+-- name: ListAuthorBooks :many
+SELECT authors.id, books.id, books.title
+FROM authors
+JOIN books ON books.author_id = authors.id;
+
+-- name: ListBookTitles :many
+SELECT title FROM books;
+
+-- name: GetBookUnordered :one
+SELECT title, status, author_id, id FROM books
+WHERE id = $1;
@@ -0,0 +1,14 @@
+CREATE TYPE book_status AS ENUM ('available', 'checked_out');
+
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL,
+  bio  text
+);
+
+CREATE TABLE books (
+  id        BIGSERIAL   PRIMARY KEY,
+  author_id bigint      NOT NULL REFERENCES authors(id),
+  title     text        NOT NULL,
+  status    book_status NOT NULL
+);
@@ -0,0 +1,4 @@
+-- name: ListAvailableBookTitles :many
+SELECT books.id, books.title, books.status
+FROM books
+WHERE status = 'available';
@@ -0,0 +1,9 @@
+-- name: GetAuthorByName :one
+-- Find an author by their "full name".
+SELECT * FROM "Authors"
+WHERE "fullName" = $1;
+
+-- name: ListAuthorsWithEscapes :many
+-- Matches biographies containing a backslash (\) or """.
+SELECT id, bio FROM "Authors"
+WHERE bio LIKE E'%\\%' OR bio LIKE '%"""%' OR bio = '"';
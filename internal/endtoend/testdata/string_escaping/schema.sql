@@ -0,0 +1,5 @@
+CREATE TABLE "Authors" (
+  id          BIGSERIAL PRIMARY KEY,
+  "fullName" text      NOT NULL,
+  bio         text
+);
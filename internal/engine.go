@@ -0,0 +1,171 @@
+package python
+
+import (
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// The querier methods check out a connection from connectionMethod, which is
+// bound to connVar in their body.
+const (
+	connectionMethod = "_connection"
+	connVar          = "conn"
+)
+
+// The constructors of the queriers created from engines and sessions
+const (
+	fromEngineMethod  = "from_engine"
+	fromSessionMethod = "from_session"
+)
+
+// engineMethods are the methods added to the queriers by
+// emit_engine_constructors, which queries must not override
+var engineMethods = map[string]bool{
+	fromEngineMethod:  true,
+	fromSessionMethod: true,
+	connectionMethod:  true,
+}
+
+// connSources returns the types a querier can be constructed from: a
+// connection, an engine and an ORM session.
+func connSources(async bool) (conn, engine, session *pyast.Node) {
+	if async {
+		return typeRefNode("sqlalchemy", "ext", "asyncio", "AsyncConnection"),
+			typeRefNode("sqlalchemy", "ext", "asyncio", "AsyncEngine"),
+			typeRefNode("sqlalchemy", "ext", "asyncio", "AsyncSession")
+	}
+	return typeRefNode("sqlalchemy", "engine", "Connection"),
+		typeRefNode("sqlalchemy", "engine", "Engine"),
+		typeRefNode("sqlalchemy", "orm", "Session")
+}
+
+func selfConn() *pyast.Node {
+	return poet.Attribute(poet.Name("self"), "_conn")
+}
+
+// connNode returns the connection the queries of a method run on
+func connNode(conf Config) *pyast.Node {
+	if conf.EmitEngineConstructors {
+		return poet.Name(connVar)
+	}
+	return selfConn()
+}
+
+func isInstanceNode(value, typ *pyast.Node) *pyast.Node {
+	return poet.Node(&pyast.Call{
+		Func: poet.Name("isinstance"),
+		Args: []*pyast.Node{value, typ},
+	})
+}
+
+func fromConstructorDef(conf Config, cls, name, arg string, typ *pyast.Node, doc string) *pyast.Node {
+	var body []*pyast.Node
+	if conf.EmitDocstrings {
+		body = append(body, poet.Expr(poet.Constant(doc)))
+	}
+	args := &pyast.Arguments{
+		Args: []*pyast.Arg{{Arg: "cls"}, {Arg: arg, Annotation: typ}},
+	}
+	call := &pyast.Call{
+		Func: poet.Name("cls"),
+		Args: []*pyast.Node{poet.Name(arg)},
+	}
+	if conf.EmitQueryHooks {
+		args.Args = append(args.Args, &pyast.Arg{Arg: hooksArg, Annotation: hooksAnnotation()})
+		args.Defaults = append(args.Defaults, poet.Constant(nil))
+		call.Args = append(call.Args, poet.Name(hooksArg))
+	}
+	body = append(body, poet.Return(poet.Node(call)))
+	return poet.Node(&pyast.FunctionDef{
+		Name:          name,
+		DecoratorList: []*pyast.Node{poet.Name("classmethod")},
+		Args:          args,
+		Returns:       poet.Constant(cls),
+		Body:          body,
+	})
+}
+
+// addEngineConstructors lets the querier class cls be constructed from an
+// engine or an ORM session, in addition to a connection.
+func addEngineConstructors(conf Config, cls *pyast.ClassDef, async bool) {
+	if !conf.EmitEngineConstructors {
+		return
+	}
+	conn, engine, session := connSources(async)
+	init := cls.Body[0].GetFunctionDef()
+	init.Args.Args[1].Annotation = poet.Node(&pyast.Subscript{
+		Value: poet.Name("Union"),
+		Slice: poet.Tuple(conn, engine, session),
+	})
+	cls.Body = append(cls.Body,
+		fromConstructorDef(conf, cls.Name, fromEngineMethod, "engine", engine,
+			"Create a querier that checks out a connection from engine for each call."),
+		fromConstructorDef(conf, cls.Name, fromSessionMethod, "session", session,
+			"Create a querier that runs queries on the connection of session."),
+	)
+}
+
+// connectionFunctionDef returns the method checking out the connection of a
+// querier method. Calls on an engine run in their own transaction, which is
+// committed when the method returns.
+func connectionFunctionDef(async bool) *pyast.Node {
+	conn, engine, session := connSources(async)
+	fromEngine := []*pyast.WithItem{{
+		ContextExpr:  poet.Node(&pyast.Call{Func: poet.Attribute(selfConn(), "begin")}),
+		OptionalVars: poet.Name(connVar),
+	}}
+	yieldConn := []*pyast.Node{poet.Expr(poet.Yield(poet.Name(connVar)))}
+	var withEngine *pyast.Node
+	sessionConn := poet.Node(&pyast.Call{Func: poet.Attribute(selfConn(), "connection")})
+	if async {
+		withEngine = poet.Node(&pyast.AsyncWith{Items: fromEngine, Body: yieldConn})
+		sessionConn = poet.Await(sessionConn)
+	} else {
+		withEngine = poet.Node(&pyast.With{Items: fromEngine, Body: yieldConn})
+	}
+	body := []*pyast.Node{
+		poet.Node(&pyast.If{
+			Test: isInstanceNode(selfConn(), engine),
+			Body: []*pyast.Node{withEngine},
+			OrElse: []*pyast.Node{
+				poet.Node(&pyast.If{
+					Test:   isInstanceNode(selfConn(), session),
+					Body:   []*pyast.Node{poet.Expr(poet.Yield(sessionConn))},
+					OrElse: []*pyast.Node{poet.Expr(poet.Yield(selfConn()))},
+				}),
+			},
+		}),
+	}
+	if async {
+		return poet.Node(&pyast.AsyncFunctionDef{
+			Name:          connectionMethod,
+			DecoratorList: []*pyast.Node{typeRefNode("contextlib", "asynccontextmanager")},
+			Args:          &pyast.Arguments{Args: []*pyast.Arg{{Arg: "self"}}},
+			Returns:       subscriptNode("AsyncIterator", conn),
+			Body:          body,
+		})
+	}
+	return poet.Node(&pyast.FunctionDef{
+		Name:          connectionMethod,
+		DecoratorList: []*pyast.Node{typeRefNode("contextlib", "contextmanager")},
+		Args:          &pyast.Arguments{Args: []*pyast.Arg{{Arg: "self"}}},
+		Returns:       subscriptNode("Iterator", conn),
+		Body:          body,
+	})
+}
+
+// engineImports adds the imports of the engine and session constructors
+func engineImports(conf Config, std, pkg map[string]importSpec) {
+	if !conf.EmitEngineConstructors {
+		return
+	}
+	std["contextlib"] = importSpec{Module: "contextlib"}
+	std["typing.Union"] = importSpec{Module: "typing", Name: "Union"}
+	if conf.EmitSyncQuerier {
+		std["typing.Iterator"] = importSpec{Module: "typing", Name: "Iterator"}
+		pkg["sqlalchemy.orm"] = importSpec{Module: "sqlalchemy.orm"}
+	}
+	if conf.EmitAsyncQuerier {
+		std["typing.AsyncIterator"] = importSpec{Module: "typing", Name: "AsyncIterator"}
+	}
+}
@@ -0,0 +1,91 @@
+package python
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// enumMemberName returns the name of the member of an enum holding value.
+// Names that can't start an identifier, or that enum.Enum wouldn't turn into
+// members, are prefixed with VALUE. The names are upper case, so they are
+// never Python keywords.
+func enumMemberName(value string) string {
+	name := pyEnumValueName(value)
+	if name == "" {
+		return "VALUE"
+	}
+	if c := name[0]; '0' <= c && c <= '9' {
+		return "VALUE_" + name
+	}
+	if reservedEnumMemberName(name) {
+		return "VALUE" + name
+	}
+	return name
+}
+
+// reservedEnumMemberName reports whether enum.Enum keeps name as a plain
+// attribute rather than a member: _sunder_ and __dunder__ names are reserved,
+// and __private names are mangled.
+func reservedEnumMemberName(name string) bool {
+	if strings.HasPrefix(name, "__") {
+		return true
+	}
+	return len(name) > 2 && name[0] == '_' && name[len(name)-1] == '_'
+}
+
+// enumMembers returns the names of the members of the enum sqlName, taking
+// the names set with enum_value_names over the ones derived from the values.
+func enumMembers(conf Config, sqlName string, values []string) ([]string, error) {
+	mapped := conf.EnumValueNames[sqlName]
+	names := make([]string, len(values))
+	byName := map[string]string{}
+	for i, v := range values {
+		name, ok := mapped[v]
+		if ok {
+			if !pyIdentifierPattern.MatchString(name) || reservedEnumMemberName(name) {
+				return nil, fmt.Errorf("enum_value_names: %s: invalid member name %q", sqlName, name)
+			}
+			name = escapeKeyword(name)
+		} else {
+			name = enumMemberName(v)
+		}
+		if prev, ok := byName[name]; ok {
+			return nil, fmt.Errorf("enum %s: values %q and %q both map to member %s, set their names with enum_value_names", sqlName, prev, v, name)
+		}
+		byName[name] = v
+		names[i] = name
+	}
+	return names, nil
+}
+
+// checkEnumValueNames checks that enum_value_names only refers to existing
+// enums and values, so that typos don't go unnoticed
+func checkEnumValueNames(conf Config, values map[string][]string) error {
+	var enums []string
+	for e := range conf.EnumValueNames {
+		enums = append(enums, e)
+	}
+	sort.Strings(enums)
+	for _, e := range enums {
+		vals, ok := values[e]
+		if !ok {
+			return fmt.Errorf("enum_value_names: unknown enum %s", e)
+		}
+		known := map[string]bool{}
+		for _, v := range vals {
+			known[v] = true
+		}
+		var unknown []string
+		for v := range conf.EnumValueNames[e] {
+			if !known[v] {
+				unknown = append(unknown, v)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return fmt.Errorf("enum_value_names: %s: unknown value %q", e, unknown[0])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package python
+
+import (
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+const errorsModule = "errors"
+
+// raisesNoRows reports whether :one queries return their row type and raise
+// NoRowsError, instead of returning None, when no row is found.
+func raisesNoRows(conf Config) bool {
+	return conf.EmitNoRowsError || conf.EmitTooManyRowsError
+}
+
+// queryRaisesErrors reports whether a query module raises the errors defined
+// in the errors module.
+func queryRaisesErrors(conf Config, queries []Query, module string) bool {
+	if !raisesNoRows(conf) {
+		return false
+	}
+	for _, q := range queries {
+		if q.ModuleName == module && q.Cmd == ":one" {
+			return true
+		}
+	}
+	return false
+}
+
+func errorClassDef(name, doc string) *pyast.Node {
+	return poet.Node(&pyast.ClassDef{
+		Name:  name,
+		Bases: []*pyast.Node{poet.Name("Exception")},
+		Body:  []*pyast.Node{poet.Expr(poet.Constant(doc))},
+	})
+}
+
+// buildErrorsTree emits the exceptions raised by the generated queriers
+func buildErrorsTree(ctx *pyTmplCtx) *pyast.Node {
+	mod := moduleNode(ctx.SqlcVersion, "")
+	mod.Body = append(mod.Body,
+		errorClassDef("NoRowsError", "Raised when a :one query returns no rows."),
+		errorClassDef("TooManyRowsError", "Raised when a :one query returns more than one row."),
+	)
+	return poet.Node(mod)
+}
+
+func raiseErrorNode(name, message string) *pyast.Node {
+	return poet.Raise(poet.Node(&pyast.Call{
+		Func: typeRefNode(errorsModule, name),
+		Args: []*pyast.Node{poet.Constant(message)},
+	}))
+}
+
+// oneNodes returns the statements of a :one method fetching the row of
+// result, an execute call or its awaited value, and its return type.
+func oneNodes(conf Config, q Query, result *pyast.Node) ([]*pyast.Node, *pyast.Node) {
+	rowIsNone := poet.Node(&pyast.Compare{
+		Left:        poet.Name("row"),
+		Ops:         []*pyast.Node{poet.Is()},
+		Comparators: []*pyast.Node{poet.Constant(nil)},
+	})
+	noRows := raiseErrorNode("NoRowsError", q.MethodName+": no rows in result set")
+
+	if conf.EmitTooManyRowsError {
+		// At most two rows are fetched, which is enough to tell that
+		// there is more than one
+		rowCount := poet.Node(&pyast.Call{
+			Func: poet.Name("len"),
+			Args: []*pyast.Node{poet.Name("rows")},
+		})
+		// The result is closed as first() does, since it may not be
+		// exhausted
+		return []*pyast.Node{
+			assignNode("result", result),
+			assignNode("rows", poet.Node(&pyast.Call{
+				Func: poet.Attribute(poet.Name("result"), "fetchmany"),
+				Args: []*pyast.Node{constantInt(2)},
+			})),
+			poet.Expr(poet.Node(&pyast.Call{
+				Func: poet.Attribute(poet.Name("result"), "close"),
+			})),
+			poet.Node(&pyast.If{
+				Test: poet.Node(&pyast.Compare{
+					Left:        rowCount,
+					Ops:         []*pyast.Node{poet.Node(&pyast.Eq{})},
+					Comparators: []*pyast.Node{constantInt(0)},
+				}),
+				Body: []*pyast.Node{noRows},
+			}),
+			poet.Node(&pyast.If{
+				Test: poet.Node(&pyast.Compare{
+					Left:        rowCount,
+					Ops:         []*pyast.Node{poet.Node(&pyast.Gt{})},
+					Comparators: []*pyast.Node{constantInt(1)},
+				}),
+				Body: []*pyast.Node{
+					raiseErrorNode("TooManyRowsError", q.MethodName+": more than one row in result set"),
+				},
+			}),
+			assignNode("row", subscriptNode("rows", constantInt(0))),
+			poet.Return(q.Ret.RowNode("row")),
+		}, q.Ret.Annotation()
+	}
+
+	first := assignNode("row", poet.Node(&pyast.Call{
+		Func: poet.Attribute(result, "first"),
+	}))
+	if conf.EmitNoRowsError {
+		return []*pyast.Node{
+			first,
+			poet.Node(&pyast.If{
+				Test: rowIsNone,
+				Body: []*pyast.Node{noRows},
+			}),
+			poet.Return(q.Ret.RowNode("row")),
+		}, q.Ret.Annotation()
+	}
+	return []*pyast.Node{
+		first,
+		poet.Node(&pyast.If{
+			Test: rowIsNone,
+			Body: []*pyast.Node{poet.Return(poet.Constant(nil))},
+		}),
+		poet.Return(q.Ret.RowNode("row")),
+	}, subscriptNode("Optional", q.Ret.Annotation())
+}
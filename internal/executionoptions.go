@@ -0,0 +1,235 @@
+package python
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// executionOption is an execution option of a query, passed to SQLAlchemy
+// with Executable.execution_options. Values are bools, ints, strings or nil.
+type executionOption struct {
+	Key   string
+	Value interface{}
+}
+
+// statementTimeoutOption is not passed to SQLAlchemy: the timeout is set with
+// SET LOCAL before the query runs.
+const statementTimeoutOption = "statement_timeout"
+
+// The statement variable of methods with execution options
+const stmtVar = "stmt"
+
+var statementTimeoutPattern = regexp.MustCompile(`^[0-9]+(us|ms|s|min|h|d)?$`)
+
+func checkExecutionOption(o executionOption) error {
+	if !pyIdentifierPattern.MatchString(o.Key) {
+		return fmt.Errorf("invalid option name %q", o.Key)
+	}
+	switch o.Key {
+	case "isolation_level":
+		return fmt.Errorf("isolation_level can only be set on the connection or engine, not on a query")
+	case statementTimeoutOption:
+		switch v := o.Value.(type) {
+		case int:
+		case string:
+			if !statementTimeoutPattern.MatchString(v) {
+				return fmt.Errorf("invalid %s %q", statementTimeoutOption, v)
+			}
+		default:
+			return fmt.Errorf("invalid %s %v", statementTimeoutOption, v)
+		}
+	}
+	return nil
+}
+
+// checkStatementTimeout checks that statement_timeout is only set for
+// PostgreSQL, since other engines have no SET LOCAL statement_timeout.
+func checkStatementTimeout(opts []executionOption, engine string) error {
+	if engine == "postgresql" {
+		return nil
+	}
+	for _, o := range opts {
+		if o.Key == statementTimeoutOption {
+			return fmt.Errorf("%s is only supported by the postgresql engine", statementTimeoutOption)
+		}
+	}
+	return nil
+}
+
+// parseExecutionOptionValue converts the value of an annotation option to a
+// bool, None or an int when possible.
+func parseExecutionOptionValue(s string) interface{} {
+	switch s {
+	case "true", "True":
+		return true
+	case "false", "False":
+		return false
+	case "None":
+		return nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		return n
+	}
+	return s
+}
+
+// parseExecutionOptions parses the value of the @execution_options
+// annotation, a list of key=value pairs separated by spaces.
+func parseExecutionOptions(value string) ([]executionOption, error) {
+	var opts []executionOption
+	for _, field := range strings.Fields(value) {
+		key, val, ok := strings.Cut(field, "=")
+		if !ok || val == "" {
+			return nil, fmt.Errorf("@execution_options: invalid option %q", field)
+		}
+		o := executionOption{Key: key, Value: parseExecutionOptionValue(val)}
+		if err := checkExecutionOption(o); err != nil {
+			return nil, fmt.Errorf("@execution_options: %w", err)
+		}
+		opts = append(opts, o)
+	}
+	if len(opts) == 0 {
+		return nil, fmt.Errorf("@execution_options: no options")
+	}
+	return opts, nil
+}
+
+// configExecutionOptions returns the execution_options of the plugin
+// options, sorted by name.
+func configExecutionOptions(conf Config) ([]executionOption, error) {
+	var opts []executionOption
+	for key, value := range conf.ExecutionOptions {
+		o := executionOption{Key: key, Value: value}
+		switch v := value.(type) {
+		case nil, bool, string:
+		case float64:
+			if v != math.Trunc(v) || math.Abs(v) > math.MaxInt32 {
+				return nil, fmt.Errorf("invalid execution_options: %s: %v is not an integer", key, v)
+			}
+			o.Value = int(v)
+		default:
+			return nil, fmt.Errorf("invalid execution_options: %s: unsupported value %v", key, v)
+		}
+		if err := checkExecutionOption(o); err != nil {
+			return nil, fmt.Errorf("invalid execution_options: %w", err)
+		}
+		opts = append(opts, o)
+	}
+	sort.Slice(opts, func(i, j int) bool { return opts[i].Key < opts[j].Key })
+	return opts, nil
+}
+
+// mergeExecutionOptions returns the default options overridden by the options
+// of a query
+func mergeExecutionOptions(defaults, opts []executionOption) []executionOption {
+	var merged []executionOption
+	set := map[string]bool{}
+	for _, o := range opts {
+		set[o.Key] = true
+	}
+	for _, o := range defaults {
+		if !set[o.Key] {
+			merged = append(merged, o)
+		}
+	}
+	return append(merged, opts...)
+}
+
+func executionOptionNode(value interface{}) *pyast.Node {
+	if n, ok := value.(int); ok {
+		return constantInt(n)
+	}
+	return poet.Constant(value)
+}
+
+// textNode returns the statement executed by a querier method
+func (q Query) textNode(constant string) *pyast.Node {
+	for _, o := range q.ExecutionOptions {
+		if o.Key != statementTimeoutOption {
+			return poet.Name(stmtVar)
+		}
+	}
+	return poet.Node(&pyast.Call{
+		Func: typeRefNode("sqlalchemy", "text"),
+		Args: []*pyast.Node{poet.Name(constant)},
+	})
+}
+
+// The variable holding the statement timeout set before the query runs
+const previousTimeoutVar = "previous_timeout"
+
+// executionOptionNodes returns the statements run before the query of a
+// querier method: setting the statement timeout and building the statement
+// with its execution options. The statements resetting the timeout to its
+// previous value, run once the query is executed, are returned in after.
+func (q Query) executionOptionNodes(conf Config, constant string, async bool) (before, after []*pyast.Node) {
+	var keywords []*pyast.Keyword
+	execute := func(sql string, args ...*pyast.Node) *pyast.Node {
+		call := poet.Node(&pyast.Call{
+			Func: poet.Attribute(connNode(conf), "execute"),
+			Args: append([]*pyast.Node{poet.Node(&pyast.Call{
+				Func: typeRefNode("sqlalchemy", "text"),
+				Args: []*pyast.Node{poet.Constant(sql)},
+			})}, args...),
+		})
+		if async {
+			return poet.Await(call)
+		}
+		return call
+	}
+	for _, o := range q.ExecutionOptions {
+		if o.Key != statementTimeoutOption {
+			keywords = append(keywords, &pyast.Keyword{Arg: o.Key, Value: executionOptionNode(o.Value)})
+			continue
+		}
+		value := fmt.Sprint(o.Value)
+		if _, ok := o.Value.(string); ok {
+			value = "'" + value + "'"
+		}
+		before = append(before,
+			assignNode(previousTimeoutVar, poet.Node(&pyast.Call{
+				Func: poet.Attribute(execute("SELECT current_setting('"+statementTimeoutOption+"')"), "scalar_one"),
+			})),
+			poet.Expr(execute("SET LOCAL "+statementTimeoutOption+" = "+value)),
+		)
+		after = append(after, poet.Expr(execute(
+			"SELECT set_config('"+statementTimeoutOption+"', :value, true)",
+			&pyast.Node{Node: &pyast.Node_Dict{Dict: &pyast.Dict{
+				Keys:   []*pyast.Node{poet.Constant("value")},
+				Values: []*pyast.Node{poet.Name(previousTimeoutVar)},
+			}}},
+		)))
+	}
+	if len(keywords) > 0 {
+		before = append(before, assignNode(stmtVar, poet.Node(&pyast.Call{
+			Func: poet.Attribute(poet.Node(&pyast.Call{
+				Func: typeRefNode("sqlalchemy", "text"),
+				Args: []*pyast.Node{poet.Name(constant)},
+			}), "execution_options"),
+			Keywords: keywords,
+		})))
+	}
+	return before, after
+}
+
+// resetAfterExecute inserts the statements resetting the statement timeout
+// after the first statement of body, which executes the query. A result that
+// is returned right away is assigned first, so that the timeout is reset
+// before the method returns. If the query fails, the transaction is aborted,
+// and rolling it back resets the timeout instead.
+func resetAfterExecute(body, reset []*pyast.Node) []*pyast.Node {
+	first, rest := body[0], body[1:]
+	if ret := first.GetReturn(); ret != nil {
+		first = assignNode("result", ret.Value)
+		rest = []*pyast.Node{poet.Return(poet.Name("result"))}
+	}
+	nodes := append([]*pyast.Node{first}, reset...)
+	return append(nodes, rest...)
+}
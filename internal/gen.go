@@ -9,6 +9,8 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/sqlc-dev/plugin-sdk-go/metadata"
 	"github.com/sqlc-dev/plugin-sdk-go/plugin"
@@ -34,13 +36,24 @@ type Enum struct {
 
 type pyType struct {
 	InnerType string
-	IsArray   bool
+	// ArrayDims is the number of array dimensions, zero for non-array types.
+	ArrayDims int
 	IsNull    bool
+	// IsElementNull marks the innermost array elements as nullable, as
+	// PostgreSQL arrays may contain NULLs even when the column does not.
+	IsElementNull bool
+}
+
+func (t pyType) IsArray() bool {
+	return t.ArrayDims > 0
 }
 
 func (t pyType) Annotation() *pyast.Node {
 	ann := poet.Name(t.InnerType)
-	if t.IsArray {
+	if t.IsElementNull {
+		ann = subscriptNode("Optional", ann)
+	}
+	for i := 0; i < t.ArrayDims; i++ {
 		ann = subscriptNode("List", ann)
 	}
 	if t.IsNull {
@@ -56,10 +69,12 @@ type Field struct {
 }
 
 type Struct struct {
-	Table   plugin.Identifier
+	Table   *plugin.Identifier
 	Name    string
 	Fields  []Field
 	Comment string
+	// Module is the module a shared class is emitted in, see dedupeStructs
+	Module string
 }
 
 type QueryValue struct {
@@ -67,6 +82,15 @@ type QueryValue struct {
 	Name   string
 	Struct *Struct
 	Typ    pyType
+	// Positions holds the index in the result row of each field of Struct,
+	// when the columns are not selected in the same order as the fields.
+	Positions []int
+	// Columns holds the names of the result columns when fields are read
+	// by name, see row_access
+	Columns []string
+	// ModelConstruct and FromRow are set by row_constructor
+	ModelConstruct bool
+	FromRow        bool
 }
 
 func (v QueryValue) Annotation() *pyast.Node {
@@ -76,6 +100,8 @@ func (v QueryValue) Annotation() *pyast.Node {
 	if v.Struct != nil {
 		if v.Emit {
 			return poet.Name(v.Struct.Name)
+		} else if v.Struct.Module != "" {
+			return typeRefNode(v.Struct.Module, v.Struct.Name)
 		} else {
 			return typeRefNode("models", v.Struct.Name)
 		}
@@ -102,16 +128,38 @@ func (v QueryValue) RowNode(rowVar string) *pyast.Node {
 			constantInt(0),
 		)
 	}
+	if v.callsFromRow() {
+		return poet.Node(&pyast.Call{
+			Func: poet.Attribute(v.Annotation(), fromRowMethod),
+			Args: []*pyast.Node{poet.Name(rowVar)},
+		})
+	}
+	return v.constructorNode(v.Annotation(), rowVar)
+}
+
+// constructorNode calls the class cls with the fields of v read from the row
+func (v QueryValue) constructorNode(cls *pyast.Node, rowVar string) *pyast.Node {
 	call := &pyast.Call{
-		Func: v.Annotation(),
+		Func: cls,
+	}
+	if v.ModelConstruct {
+		call.Func = poet.Attribute(cls, "model_construct")
 	}
 	for i, f := range v.Struct.Fields {
+		pos := i
+		if v.Positions != nil {
+			pos = v.Positions[i]
+		}
+		value := subscriptNode(rowVar, constantInt(pos))
+		if v.Columns != nil && v.Columns[pos] != "" {
+			value = poet.Node(&pyast.Subscript{
+				Value: poet.Attribute(poet.Name(rowVar), "_mapping"),
+				Slice: poet.Constant(v.Columns[pos]),
+			})
+		}
 		call.Keywords = append(call.Keywords, &pyast.Keyword{
-			Arg: f.Name,
-			Value: subscriptNode(
-				rowVar,
-				constantInt(i),
-			),
+			Arg:   f.Name,
+			Value: value,
 		})
 	}
 	return &pyast.Node{
@@ -129,9 +177,22 @@ type Query struct {
 	FieldName    string
 	ConstantName string
 	SQL          string
-	SourceName   string
-	Ret          QueryValue
-	Args         []QueryValue
+	// Text is the SQL of the query as written, before it is rewritten for
+	// SQLAlchemy
+	Text        string
+	SourceName  string
+	ModuleName  string
+	Ret         QueryValue
+	Args        []QueryValue
+	Annotations queryAnnotations
+	// ManyAsList is set for :many queries returning a list, which also get
+	// a streaming method named with the _iter suffix
+	ManyAsList bool
+	// Paginate is set for queries with a paging method, see @paginate
+	Paginate *pagination
+	// ExecutionOptions are the options of the execution_options plugin
+	// option, overridden by those of @execution_options
+	ExecutionOptions []executionOption
 }
 
 func (q Query) AddArgs(args *pyast.Arguments) {
@@ -180,12 +241,18 @@ func (q Query) ArgDictNode() *pyast.Node {
 	}
 }
 
-func makePyType(req *plugin.GenerateRequest, col *plugin.Column) pyType {
+func makePyType(conf Config, req *plugin.GenerateRequest, col *plugin.Column) pyType {
 	typ := pyInnerType(req, col)
+	dims := int(col.ArrayDims)
+	if col.IsArray && dims == 0 {
+		// Older versions of sqlc do not populate ArrayDims
+		dims = 1
+	}
 	return pyType{
-		InnerType: typ,
-		IsArray:   col.IsArray,
-		IsNull:    !col.NotNull,
+		InnerType:     typ,
+		ArrayDims:     dims,
+		IsNull:        !col.NotNull,
+		IsElementNull: dims > 0 && conf.EmitOptionalArrayElements,
 	}
 }
 
@@ -201,10 +268,13 @@ func pyInnerType(req *plugin.GenerateRequest, col *plugin.Column) string {
 
 func modelName(name string, settings *plugin.Settings) string {
 	out := ""
-	for _, p := range strings.Split(name, "_") {
+	for _, p := range strings.Split(pyNameInvalidPattern.ReplaceAllString(name, "_"), "_") {
 		out += strings.Title(p)
 	}
-	return out
+	if r, _ := utf8.DecodeRuneInString(out); unicode.IsDigit(r) {
+		out = "_" + out
+	}
+	return escapeKeyword(out)
 }
 
 var matchFirstCap = regexp.MustCompile("(.)([A-Z][a-z]+)")
@@ -213,7 +283,7 @@ var matchAllCap = regexp.MustCompile("([a-z0-9])([A-Z])")
 func methodName(name string) string {
 	snake := matchFirstCap.ReplaceAllString(name, "${1}_${2}")
 	snake = matchAllCap.ReplaceAllString(snake, "${1}_${2}")
-	return strings.ToLower(snake)
+	return escapeKeyword(strings.ToLower(snake))
 }
 
 var pyIdentPattern = regexp.MustCompile("[^a-zA-Z0-9_]+")
@@ -226,26 +296,34 @@ func pyEnumValueName(value string) string {
 	return strings.ToUpper(id)
 }
 
-func buildEnums(req *plugin.GenerateRequest) []Enum {
+func buildEnums(conf Config, req *plugin.GenerateRequest) ([]Enum, error) {
 	var enums []Enum
+	values := map[string][]string{}
 	for _, schema := range req.Catalog.Schemas {
 		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
 			continue
 		}
 		for _, enum := range schema.Enums {
-			var enumName string
+			var enumName, sqlName string
 			if schema.Name == req.Catalog.DefaultSchema {
 				enumName = enum.Name
+				sqlName = enum.Name
 			} else {
 				enumName = schema.Name + "_" + enum.Name
+				sqlName = schema.Name + "." + enum.Name
 			}
+			values[sqlName] = enum.Vals
 			e := Enum{
 				Name:    modelName(enumName, req.Settings),
 				Comment: enum.Comment,
 			}
-			for _, v := range enum.Vals {
+			names, err := enumMembers(conf, sqlName, enum.Vals)
+			if err != nil {
+				return nil, err
+			}
+			for i, v := range enum.Vals {
 				e.Constants = append(e.Constants, Constant{
-					Name:  pyEnumValueName(v),
+					Name:  names[i],
 					Value: v,
 					Type:  e.Name,
 				})
@@ -253,10 +331,13 @@ func buildEnums(req *plugin.GenerateRequest) []Enum {
 			enums = append(enums, e)
 		}
 	}
+	if err := checkEnumValueNames(conf, values); err != nil {
+		return nil, err
+	}
 	if len(enums) > 0 {
 		sort.Slice(enums, func(i, j int) bool { return enums[i].Name < enums[j].Name })
 	}
-	return enums
+	return enums, nil
 }
 
 func buildModels(conf Config, req *plugin.GenerateRequest) []Struct {
@@ -280,15 +361,25 @@ func buildModels(conf Config, req *plugin.GenerateRequest) []Struct {
 				})
 			}
 			s := Struct{
-				Table:   plugin.Identifier{Schema: schema.Name, Name: table.Rel.Name},
+				Table:   &plugin.Identifier{Schema: schema.Name, Name: table.Rel.Name},
 				Name:    modelName(structName, req.Settings),
 				Comment: table.Comment,
 			}
-			for _, column := range table.Columns {
-				typ := makePyType(req, column) // TODO: This used to call compiler.ConvertColumn?
+			seen := map[string]int{}
+			for i, column := range table.Columns {
+				typ := makePyType(conf, req, column) // TODO: This used to call compiler.ConvertColumn?
 				typ.InnerType = strings.TrimPrefix(typ.InnerType, "models.")
+				// Columns whose names only differ by characters that are
+				// replaced, like "a b" and "a_b", are numbered
+				name := columnName(conf, column, i)
+				if n := seen[name]; n > 0 {
+					seen[name]++
+					name = fmt.Sprintf("%s_%d", name, n+1)
+				} else {
+					seen[name]++
+				}
 				s.Fields = append(s.Fields, Field{
-					Name:    column.Name,
+					Name:    name,
 					Type:    typ,
 					Comment: column.Comment,
 				})
@@ -302,16 +393,16 @@ func buildModels(conf Config, req *plugin.GenerateRequest) []Struct {
 	return structs
 }
 
-func columnName(c *plugin.Column, pos int) string {
+func columnName(conf Config, c *plugin.Column, pos int) string {
 	if c.Name != "" {
-		return c.Name
+		return identifierName(conf, c.Name)
 	}
 	return fmt.Sprintf("column_%d", pos+1)
 }
 
-func paramName(p *plugin.Parameter) string {
+func paramName(conf Config, p *plugin.Parameter) string {
 	if p.Column.Name != "" {
-		return p.Column.Name
+		return argName(conf, p.Column.Name)
 	}
 	return fmt.Sprintf("dollar_%d", p.Number)
 }
@@ -321,14 +412,14 @@ type pyColumn struct {
 	*plugin.Column
 }
 
-func columnsToStruct(req *plugin.GenerateRequest, name string, columns []pyColumn) *Struct {
+func columnsToStruct(conf Config, req *plugin.GenerateRequest, name string, columns []pyColumn) *Struct {
 	gs := Struct{
 		Name: name,
 	}
 	seen := map[string]int32{}
 	suffixes := map[int32]int32{}
 	for i, c := range columns {
-		colName := columnName(c.Column, i)
+		colName := columnName(conf, c.Column, i)
 		fieldName := colName
 		// Track suffixes by the ID of the column, so that columns referring to
 		// the same numbered parameter can be reused.
@@ -344,7 +435,7 @@ func columnsToStruct(req *plugin.GenerateRequest, name string, columns []pyColum
 		}
 		gs.Fields = append(gs.Fields, Field{
 			Name: fieldName,
-			Type: makePyType(req, c.Column),
+			Type: makePyType(conf, req, c.Column),
 		})
 		seen[colName]++
 	}
@@ -356,15 +447,80 @@ var postgresPlaceholderRegexp = regexp.MustCompile(`\B\$(\d+)\b`)
 // Sqlalchemy uses ":name" for placeholders, so "$N" is converted to ":pN"
 // This also means ":" has special meaning to sqlalchemy, so it must be escaped.
 func sqlalchemySQL(s, engine string) string {
-	s = strings.ReplaceAll(s, ":", `\\:`)
+	s = strings.ReplaceAll(s, ":", `\:`)
 	if engine == "postgresql" {
 		return postgresPlaceholderRegexp.ReplaceAllString(s, ":p$1")
 	}
 	return s
 }
 
-func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([]Query, error) {
+// quoteIdentifier quotes the name of a column for the engine, so that names
+// with spaces, upper case letters or reserved words can be referenced. The
+// ":" are escaped for sqlalchemy like in sqlalchemySQL.
+func quoteIdentifier(name, engine string) string {
+	if engine == "mysql" {
+		name = "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	} else {
+		name = `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	}
+	return strings.ReplaceAll(name, ":", `\:`)
+}
+
+func sameFields(a, b []Field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Type != b[i].Type {
+			return false
+		}
+	}
+	return true
+}
+
+// namedStructs tracks the classes emitted in each query module, so that
+// queries annotated with the same @row_type or @params_type share a class.
+type namedStructs map[string]map[string]*Struct
+
+func (n namedStructs) add(module string, s *Struct) (*Struct, error) {
+	if n[module] == nil {
+		n[module] = map[string]*Struct{}
+	}
+	if prev, ok := n[module][s.Name]; ok {
+		if !sameFields(prev.Fields, s.Fields) {
+			return nil, fmt.Errorf("%s: class %s is used for different columns", module, s.Name)
+		}
+		return prev, nil
+	}
+	n[module][s.Name] = s
+	return s, nil
+}
+
+// buildQueries returns the queries to generate, along with the projections of
+// models they return, if enabled.
+func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([]Query, []Struct, error) {
+	if !validModelMatching(conf.ModelMatching) {
+		return nil, nil, fmt.Errorf("invalid model_matching: %q", conf.ModelMatching)
+	}
+	if err := checkRename(conf); err != nil {
+		return nil, nil, err
+	}
+	if !validRowAccess(conf.RowAccess) {
+		return nil, nil, fmt.Errorf("invalid row_access: %q", conf.RowAccess)
+	}
+	if err := checkRowConstructor(conf); err != nil {
+		return nil, nil, err
+	}
+	defaultExecOpts, err := configExecutionOptions(conf)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkStatementTimeout(defaultExecOpts, req.Settings.Engine); err != nil {
+		return nil, nil, fmt.Errorf("invalid execution_options: %w", err)
+	}
 	qs := make([]Query, 0, len(req.Queries))
+	named := namedStructs{}
+	projected := projections{}
 	for _, query := range req.Queries {
 		if query.Name == "" {
 			continue
@@ -373,19 +529,46 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 			continue
 		}
 		if query.Cmd == metadata.CmdCopyFrom {
-			return nil, errors.New("Support for CopyFrom in Python is not implemented")
+			return nil, nil, errors.New("Support for CopyFrom in Python is not implemented")
 		}
 
+		ann, comments, err := parseQueryAnnotations(query.Comments)
+		if err != nil {
+			return nil, nil, fmt.Errorf("query %s: %w", query.Name, err)
+		}
+		if err := checkStatementTimeout(ann.ExecutionOptions, req.Settings.Engine); err != nil {
+			return nil, nil, fmt.Errorf("query %s: @execution_options: %w", query.Name, err)
+		}
+		execOpts := mergeExecutionOptions(defaultExecOpts, ann.ExecutionOptions)
+
 		methodName := methodName(query.Name)
 
 		gq := Query{
 			Cmd:          query.Cmd,
-			Comments:     query.Comments,
+			Comments:     comments,
 			MethodName:   methodName,
 			FieldName:    sdk.LowerTitle(query.Name) + "Stmt",
 			ConstantName: strings.ToUpper(methodName),
 			SQL:          sqlalchemySQL(query.Text, req.Settings.Engine),
+			Text:         query.Text,
 			SourceName:   query.Filename,
+			ModuleName:   queryModuleName(conf, query.Filename),
+			Annotations:  ann,
+
+			ExecutionOptions: execOpts,
+		}
+
+		if ann.Many != "" && query.Cmd != metadata.CmdMany {
+			return nil, nil, fmt.Errorf("query %s: @many: not a :many query", query.Name)
+		}
+		if ann.Paginate != "" && query.Cmd != metadata.CmdMany {
+			return nil, nil, fmt.Errorf("query %s: @paginate: not a :many query", query.Name)
+		}
+		if query.Cmd == metadata.CmdMany {
+			gq.ManyAsList = conf.EmitManyAsList
+			if ann.Many != "" {
+				gq.ManyAsList = ann.Many == manyList
+			}
 		}
 
 		qpl := 4
@@ -393,9 +576,9 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 			qpl = int(*conf.QueryParameterLimit)
 		}
 		if qpl < 0 {
-			return nil, errors.New("invalid query parameter limit")
+			return nil, nil, errors.New("invalid query parameter limit")
 		}
-		if len(query.Params) > qpl || qpl == 0 {
+		if len(query.Params) > qpl || qpl == 0 || (ann.ParamsType != "" && len(query.Params) > 0) {
 			var cols []pyColumn
 			for _, p := range query.Params {
 				cols = append(cols, pyColumn{
@@ -403,54 +586,56 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 					Column: p.Column,
 				})
 			}
+			paramsName := query.Name + "Params"
+			if ann.ParamsType != "" {
+				paramsName = ann.ParamsType
+			}
+			gs, err := named.add(gq.ModuleName, columnsToStruct(conf, req, paramsName, cols))
+			if err != nil {
+				return nil, nil, err
+			}
 			gq.Args = []QueryValue{{
 				Emit:   true,
 				Name:   "arg",
-				Struct: columnsToStruct(req, query.Name+"Params", cols),
+				Struct: gs,
 			}}
 		} else {
 			args := make([]QueryValue, 0, len(query.Params))
 			for _, p := range query.Params {
 				args = append(args, QueryValue{
-					Name: paramName(p),
-					Typ:  makePyType(req, p.Column),
+					Name: paramName(conf, p),
+					Typ:  makePyType(conf, req, p.Column),
 				})
 			}
 			gq.Args = args
 		}
+		if err := gq.checkParamAnnotations(); err != nil {
+			return nil, nil, fmt.Errorf("query %s: %w", query.Name, err)
+		}
 
-		if len(query.Columns) == 1 {
+		if len(query.Columns) == 1 && ann.RowType == "" {
 			c := query.Columns[0]
 			gq.Ret = QueryValue{
-				Name: columnName(c, 0),
-				Typ:  makePyType(req, c),
+				Name: columnName(conf, c, 0),
+				Typ:  makePyType(conf, req, c),
 			}
-		} else if len(query.Columns) > 1 {
+		} else if len(query.Columns) > 0 {
 			var gs *Struct
 			var emit bool
-
-			for _, s := range structs {
-				if len(s.Fields) != len(query.Columns) {
-					continue
-				}
-				same := true
-
-				for i, f := range s.Fields {
-					c := query.Columns[i]
-					// HACK: models do not have "models." on their types, so trim that so we can find matches
-					trimmedPyType := makePyType(req, c)
-					trimmedPyType.InnerType = strings.TrimPrefix(trimmedPyType.InnerType, "models.")
-					sameName := f.Name == columnName(c, i)
-					sameType := f.Type == trimmedPyType
-					sameTable := sdk.SameTableName(c.Table, &s.Table, req.Catalog.DefaultSchema)
-					if !sameName || !sameType || !sameTable {
-						same = false
+			var positions []int
+
+			// An explicitly named row type is never replaced by a model
+			if ann.RowType == "" {
+				columns := modelColumns(conf, req, query.Columns)
+				gs, positions = matchModel(conf, req, structs, columns)
+				if gs == nil && conf.EmitModelProjections {
+					if p := projectModel(req, structs, columns); p != nil {
+						gs, err = projected.add(p, structs)
+						if err != nil {
+							return nil, nil, err
+						}
 					}
 				}
-				if same {
-					gs = &s
-					break
-				}
 			}
 
 			if gs == nil {
@@ -461,20 +646,175 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 						Column: c,
 					})
 				}
-				gs = columnsToStruct(req, query.Name+"Row", columns)
+				rowName := query.Name + "Row"
+				if ann.RowType != "" {
+					rowName = ann.RowType
+				}
+				gs, err = named.add(gq.ModuleName, columnsToStruct(conf, req, rowName, columns))
+				if err != nil {
+					return nil, nil, err
+				}
 				emit = true
 			}
 			gq.Ret = QueryValue{
-				Emit:   emit,
-				Name:   "i",
-				Struct: gs,
+				Emit:           emit,
+				Name:           "i",
+				Struct:         gs,
+				Positions:      positions,
+				ModelConstruct: skipsValidation(conf),
+				FromRow:        conf.RowConstructor == rowConstructorFromRow,
+			}
+			if conf.RowAccess == rowAccessName {
+				gq.Ret.Columns = resultColumnNames(query.Columns)
+			}
+		}
+
+		if ann.Paginate != "" {
+			gq.Paginate, err = buildPagination(conf, gq, req.Settings.Engine)
+			if err == nil {
+				err = gq.checkPaginate()
+			}
+			if err != nil {
+				return nil, nil, fmt.Errorf("query %s: %w", query.Name, err)
 			}
 		}
 
 		qs = append(qs, gq)
 	}
 	sort.Slice(qs, func(i, j int) bool { return qs[i].MethodName < qs[j].MethodName })
-	return qs, nil
+	if err := checkMethodNames(conf, qs); err != nil {
+		return nil, nil, err
+	}
+	var projs []Struct
+	for _, p := range projected {
+		projs = append(projs, *p)
+	}
+	return qs, projs, nil
+}
+
+// checkMethodNames checks that the extra methods emitted for a query, like
+// the streaming and paging methods of :many queries, don't clash with the
+// methods of other queries or the querier's own methods.
+func checkMethodNames(conf Config, qs []Query) error {
+	methods := map[string]map[string]bool{}
+	for _, q := range qs {
+		if methods[q.ModuleName] == nil {
+			methods[q.ModuleName] = map[string]bool{}
+		}
+		methods[q.ModuleName][q.MethodName] = true
+	}
+	for _, q := range qs {
+		if conf.EmitTransactionHelpers && (q.MethodName == beginMethod || q.MethodName == beginNestedMethod) {
+			return fmt.Errorf("%s: method %s conflicts with the transaction helper", q.SourceName, q.MethodName)
+		}
+		if conf.EmitEngineConstructors && engineMethods[q.MethodName] {
+			return fmt.Errorf("%s: method %s conflicts with the engine and session constructors", q.SourceName, q.MethodName)
+		}
+		var extra []string
+		if q.ManyAsList {
+			extra = append(extra, iterMethodName(q))
+		}
+		if q.Paginate != nil {
+			extra = append(extra, pageMethodName(q))
+		}
+		for _, name := range extra {
+			if methods[q.ModuleName][name] {
+				return fmt.Errorf("%s: method %s already exists", q.SourceName, name)
+			}
+		}
+	}
+	return nil
+}
+
+// dedupeStructs merges the emitted row and params classes that have the same
+// fields, so that queries returning the same columns share a single class.
+// Row classes are only merged with row classes, and params classes with
+// params classes. Classes named with an annotation take precedence over
+// generated names, and are never merged with a class of another name.
+//
+// When a shared module is configured, classes are merged across query modules
+// and those used by more than one module are moved to the shared module, which
+// is returned sorted by name.
+func dedupeStructs(conf Config, qs []Query) ([]*Struct, error) {
+	type candidate struct {
+		qv     *QueryValue
+		module string
+		params bool
+		named  bool
+	}
+	var candidates []candidate
+	for i := range qs {
+		q := &qs[i]
+		for j := range q.Args {
+			if q.Args[j].EmitStruct() {
+				candidates = append(candidates, candidate{&q.Args[j], q.ModuleName, true, q.Annotations.ParamsType != ""})
+			}
+		}
+		if q.Ret.EmitStruct() {
+			candidates = append(candidates, candidate{&q.Ret, q.ModuleName, false, q.Annotations.RowType != ""})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].named && !candidates[j].named
+	})
+
+	// Unnamed classes are merged into the first class with the same
+	// fields, which is a named one if there is any
+	merged := map[string]*Struct{}
+	byFields := map[string]*Struct{}
+	modules := map[*Struct]map[string]struct{}{}
+	for _, c := range candidates {
+		var key strings.Builder
+		fmt.Fprintf(&key, "%t", c.params)
+		if conf.SharedStructsModule == "" {
+			key.WriteString(c.module)
+		}
+		for _, f := range c.qv.Struct.Fields {
+			fmt.Fprintf(&key, "\x00%s:%+v", f.Name, f.Type)
+		}
+		fields := key.String()
+		if c.named {
+			key.WriteString("\x00" + c.qv.Struct.Name)
+		}
+		s, ok := merged[key.String()]
+		if !ok && !c.named {
+			s, ok = byFields[fields]
+		}
+		if !ok {
+			s = c.qv.Struct
+			merged[key.String()] = s
+			modules[s] = map[string]struct{}{}
+			if byFields[fields] == nil {
+				byFields[fields] = s
+			}
+		}
+		c.qv.Struct = s
+		modules[s][c.module] = struct{}{}
+	}
+
+	if conf.SharedStructsModule == "" {
+		return nil, nil
+	}
+	var shared []*Struct
+	names := map[string]bool{}
+	for _, s := range merged {
+		if len(modules[s]) < 2 {
+			continue
+		}
+		if names[s.Name] {
+			return nil, fmt.Errorf("%s: class %s is used for different columns", conf.SharedStructsModule, s.Name)
+		}
+		names[s.Name] = true
+		s.Module = conf.SharedStructsModule
+		shared = append(shared, s)
+	}
+	for _, c := range candidates {
+		if c.qv.Struct.Module != "" {
+			c.qv.Emit = false
+		}
+	}
+	sort.Slice(shared, func(i, j int) bool { return shared[i].Name < shared[j].Name })
+	return shared, nil
 }
 
 func moduleNode(version, source string) *pyast.Module {
@@ -559,7 +899,7 @@ func subscriptNode(value string, slice *pyast.Node) *pyast.Node {
 	return &pyast.Node{
 		Node: &pyast.Node_Subscript{
 			Subscript: &pyast.Subscript{
-				Value: &pyast.Name{Id: value},
+				Value: poet.Name(value),
 				Slice: slice,
 			},
 		},
@@ -601,16 +941,60 @@ func pydanticNode(name string) *pyast.ClassDef {
 	}
 }
 
-func fieldNode(f Field) *pyast.Node {
-	return &pyast.Node{
-		Node: &pyast.Node_AnnAssign{
-			AnnAssign: &pyast.AnnAssign{
-				Target:     &pyast.Name{Id: f.Name},
-				Annotation: f.Type.Annotation(),
-				Comment:    f.Comment,
-			},
-		},
+const (
+	columnCommentsComment   = "comment"
+	columnCommentsDocstring = "docstring"
+	columnCommentsAnnotated = "annotated"
+)
+
+func validColumnComments(style string) bool {
+	switch style {
+	case "", columnCommentsComment, columnCommentsDocstring, columnCommentsAnnotated:
+		return true
+	}
+	return false
+}
+
+// fieldNodes returns the declaration of a field, followed by its docstring
+// when column comments are emitted as docstrings.
+func fieldNodes(conf Config, f Field) []*pyast.Node {
+	ann := &pyast.AnnAssign{
+		Target:     &pyast.Name{Id: f.Name},
+		Annotation: f.Type.Annotation(),
+	}
+	if f.Comment == "" {
+		return []*pyast.Node{poet.Node(ann)}
+	}
+	switch conf.ColumnComments {
+	case columnCommentsDocstring:
+		return []*pyast.Node{
+			poet.Node(ann),
+			poet.Expr(poet.Constant(f.Comment)),
+		}
+	case columnCommentsAnnotated:
+		// Pydantic reads the description from Field, other tools from Doc
+		meta := &pyast.Call{
+			Func: poet.Name("Doc"),
+			Args: []*pyast.Node{poet.Constant(f.Comment)},
+		}
+		if conf.EmitPydanticModels {
+			meta = &pyast.Call{
+				Func: typeRefNode("pydantic", "Field"),
+				Keywords: []*pyast.Keyword{
+					{
+						Arg:   "description",
+						Value: poet.Constant(f.Comment),
+					},
+				},
+			}
+		}
+		ann.Annotation = subscriptNode("Annotated", poet.Node(&pyast.Tuple{
+			Elts: []*pyast.Node{ann.Annotation, poet.Node(meta)},
+		}))
+	default:
+		ann.Comment = f.Comment
 	}
+	return []*pyast.Node{poet.Node(ann)}
 }
 
 func typeRefNode(base string, parts ...string) *pyast.Node {
@@ -621,26 +1005,15 @@ func typeRefNode(base string, parts ...string) *pyast.Node {
 	return n
 }
 
-func connMethodNode(method, name string, arg *pyast.Node) *pyast.Node {
-	args := []*pyast.Node{
-		{
-			Node: &pyast.Node_Call{
-				Call: &pyast.Call{
-					Func: typeRefNode("sqlalchemy", "text"),
-					Args: []*pyast.Node{
-						poet.Name(name),
-					},
-				},
-			},
-		},
-	}
+func connMethodNode(conf Config, method string, stmt, arg *pyast.Node) *pyast.Node {
+	args := []*pyast.Node{stmt}
 	if arg != nil {
 		args = append(args, arg)
 	}
 	return &pyast.Node{
 		Node: &pyast.Node_Call{
 			Call: &pyast.Call{
-				Func: typeRefNode("self", "_conn", method),
+				Func: poet.Attribute(connNode(conf), method),
 				Args: args,
 			},
 		},
@@ -731,7 +1104,10 @@ func buildModelsTree(ctx *pyTmplCtx, i *importer) *pyast.Node {
 			})
 		}
 		for _, f := range m.Fields {
-			def.Body = append(def.Body, fieldNode(f))
+			def.Body = append(def.Body, fieldNodes(ctx.C, f)...)
+		}
+		if returnsStruct(ctx.C, ctx.Queries, &m) {
+			def.Body = append(def.Body, fromRowFunctionDef(ctx.C, &m))
 		}
 		mod.Body = append(mod.Body, &pyast.Node{
 			Node: &pyast.Node_ClassDef{
@@ -740,6 +1116,20 @@ func buildModelsTree(ctx *pyTmplCtx, i *importer) *pyast.Node {
 		})
 	}
 
+	if queriesPaginate(ctx.Queries) {
+		mod.Body = append(mod.Body,
+			assignNode(pageItemVar, poet.Node(&pyast.Call{
+				Func: poet.Name("TypeVar"),
+				Args: []*pyast.Node{poet.Constant(pageItemVar)},
+			})),
+			assignNode(pageCursorVar, poet.Node(&pyast.Call{
+				Func: poet.Name("TypeVar"),
+				Args: []*pyast.Node{poet.Constant(pageCursorVar)},
+			})),
+			poet.Node(pageClassDef(ctx.C)),
+		)
+	}
+
 	return &pyast.Node{Node: &pyast.Node_Module{Module: mod}}
 }
 
@@ -819,10 +1209,81 @@ func asyncQuerierClassDef() *pyast.ClassDef {
 	}
 }
 
-func buildQueryTree(ctx *pyTmplCtx, i *importer, source string) *pyast.Node {
-	mod := moduleNode(ctx.SqlcVersion, source)
-	std, pkg := i.queryImportSpecs(source)
+func structClassDef(ctx *pyTmplCtx, s *Struct) *pyast.ClassDef {
+	var def *pyast.ClassDef
+	if ctx.C.EmitPydanticModels {
+		def = pydanticNode(s.Name)
+	} else {
+		def = dataclassNode(s.Name)
+	}
+	for _, f := range s.Fields {
+		def.Body = append(def.Body, fieldNodes(ctx.C, f)...)
+	}
+	if returnsStruct(ctx.C, ctx.Queries, s) {
+		def.Body = append(def.Body, fromRowFunctionDef(ctx.C, s))
+	}
+	return def
+}
+
+// modelsAlias imports the models module, which is always referred to as
+// "models" regardless of the name of its file.
+func modelsAlias(conf Config) *pyast.Node {
+	module := modelsModuleName(conf)
+	if module == "models" {
+		return poet.Alias(module)
+	}
+	return poet.AliasAs(module, "models")
+}
+
+func buildSharedStructsTree(ctx *pyTmplCtx, i *importer) *pyast.Node {
+	mod := moduleNode(ctx.SqlcVersion, "")
+	std, pkg := i.sharedStructImportSpecs()
 	mod.Body = append(mod.Body, buildImportGroup(std), buildImportGroup(pkg))
+	if i.sharedStructsUseModels() {
+		mod.Body = append(mod.Body, &pyast.Node{
+			Node: &pyast.Node_ImportGroup{
+				ImportGroup: &pyast.ImportGroup{
+					Imports: []*pyast.Node{
+						{
+							Node: &pyast.Node_ImportFrom{
+								ImportFrom: &pyast.ImportFrom{
+									Module: ctx.C.Package,
+									Names: []*pyast.Node{
+										modelsAlias(ctx.C),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	for _, s := range ctx.SharedStructs {
+		mod.Body = append(mod.Body, poet.Node(structClassDef(ctx, s)))
+	}
+	return poet.Node(mod)
+}
+
+func buildQueryTree(ctx *pyTmplCtx, i *importer, module string, sources []string) *pyast.Node {
+	mod := moduleNode(ctx.SqlcVersion, strings.Join(sources, ", "))
+	std, pkg := i.queryImportSpecs(module)
+	mod.Body = append(mod.Body, buildImportGroup(std), buildImportGroup(pkg))
+	localNames := []*pyast.Node{
+		modelsAlias(ctx.C),
+	}
+	if i.queryUsesSharedStructs(module) {
+		localNames = append(localNames, poet.Alias(ctx.C.SharedStructsModule))
+	}
+	if queryRaisesErrors(ctx.C, ctx.Queries, module) {
+		localNames = append(localNames, poet.Alias(errorsModule))
+	}
+	if ctx.C.EmitQueryHooks {
+		localNames = append(localNames, poet.Alias(instrumentationModule))
+	}
+	sort.Slice(localNames, func(i, j int) bool {
+		return localNames[i].GetAlias().Name < localNames[j].GetAlias().Name
+	})
 	mod.Body = append(mod.Body, &pyast.Node{
 		Node: &pyast.Node_ImportGroup{
 			ImportGroup: &pyast.ImportGroup{
@@ -831,9 +1292,7 @@ func buildQueryTree(ctx *pyTmplCtx, i *importer, source string) *pyast.Node {
 						Node: &pyast.Node_ImportFrom{
 							ImportFrom: &pyast.ImportFrom{
 								Module: ctx.C.Package,
-								Names: []*pyast.Node{
-									poet.Alias("models"),
-								},
+								Names:  localNames,
 							},
 						},
 					},
@@ -842,44 +1301,41 @@ func buildQueryTree(ctx *pyTmplCtx, i *importer, source string) *pyast.Node {
 		},
 	})
 
+	emitted := map[string]bool{}
 	for _, q := range ctx.Queries {
-		if !ctx.OutputQuery(q.SourceName) {
+		if !ctx.OutputQuery(q.ModuleName) {
 			continue
 		}
-		queryText := fmt.Sprintf("-- name: %s \\\\%s\n%s\n", q.MethodName, q.Cmd, q.SQL)
+		queryText := fmt.Sprintf("-- name: %s \\%s\n%s\n", q.MethodName, q.Cmd, q.SQL)
 		mod.Body = append(mod.Body, assignNode(q.ConstantName, poet.Constant(queryText)))
+		if q.Paginate != nil {
+			pageText := fmt.Sprintf("-- name: %s \\%s\n%s\n", pageMethodName(q), q.Cmd, pageSQL(q))
+			mod.Body = append(mod.Body, assignNode(pageConstantName(q), poet.Constant(pageText)))
+		}
 		for _, arg := range q.Args {
-			if arg.EmitStruct() {
-				var def *pyast.ClassDef
-				if ctx.C.EmitPydanticModels {
-					def = pydanticNode(arg.Struct.Name)
-				} else {
-					def = dataclassNode(arg.Struct.Name)
-				}
-				for _, f := range arg.Struct.Fields {
-					def.Body = append(def.Body, fieldNode(f))
-				}
-				mod.Body = append(mod.Body, poet.Node(def))
+			if arg.EmitStruct() && !emitted[arg.Struct.Name] {
+				emitted[arg.Struct.Name] = true
+				mod.Body = append(mod.Body, poet.Node(structClassDef(ctx, arg.Struct)))
 			}
 		}
-		if q.Ret.EmitStruct() {
-			var def *pyast.ClassDef
-			if ctx.C.EmitPydanticModels {
-				def = pydanticNode(q.Ret.Struct.Name)
-			} else {
-				def = dataclassNode(q.Ret.Struct.Name)
-			}
-			for _, f := range q.Ret.Struct.Fields {
-				def.Body = append(def.Body, fieldNode(f))
-			}
-			mod.Body = append(mod.Body, poet.Node(def))
+		if q.Ret.EmitStruct() && !emitted[q.Ret.Struct.Name] {
+			emitted[q.Ret.Struct.Name] = true
+			mod.Body = append(mod.Body, poet.Node(structClassDef(ctx, q.Ret.Struct)))
 		}
 	}
 
 	if ctx.C.EmitSyncQuerier {
 		cls := querierClassDef()
+		addQueryHooks(ctx.C, cls)
+		addEngineConstructors(ctx.C, cls, false)
+		if ctx.C.EmitEngineConstructors {
+			cls.Body = append(cls.Body, connectionFunctionDef(false))
+		}
+		if ctx.C.EmitTransactionHelpers {
+			cls.Body = append(cls.Body, transactionFunctionDefs(ctx.C, cls.Name, false)...)
+		}
 		for _, q := range ctx.Queries {
-			if !ctx.OutputQuery(q.SourceName) {
+			if !ctx.OutputQuery(q.ModuleName) {
 				continue
 			}
 			f := &pyast.FunctionDef{
@@ -894,56 +1350,51 @@ func buildQueryTree(ctx *pyTmplCtx, i *importer, source string) *pyast.Node {
 			}
 
 			q.AddArgs(f.Args)
-			exec := connMethodNode("execute", q.ConstantName, q.ArgDictNode())
+			if doc := docstringNode(ctx.C, q); doc != nil {
+				f.Body = append(f.Body, doc)
+			}
+			exec := connMethodNode(ctx.C, "execute", q.textNode(q.ConstantName), q.ArgDictNode())
+			// stream is the query of the method, with the options of a
+			// streaming :many method
+			stream := q
 
 			switch q.Cmd {
 			case ":one":
-				f.Body = append(f.Body,
-					assignNode("row", poet.Node(
-						&pyast.Call{
-							Func: poet.Attribute(exec, "first"),
-						},
-					)),
-					poet.Node(
-						&pyast.If{
-							Test: poet.Node(
-								&pyast.Compare{
-									Left: poet.Name("row"),
-									Ops: []*pyast.Node{
-										poet.Is(),
-									},
-									Comparators: []*pyast.Node{
-										poet.Constant(nil),
-									},
-								},
-							),
-							Body: []*pyast.Node{
-								poet.Return(
-									poet.Constant(nil),
-								),
-							},
-						},
-					),
-					poet.Return(q.Ret.RowNode("row")),
-				)
-				f.Returns = subscriptNode("Optional", q.Ret.Annotation())
+				body, returns := oneNodes(ctx.C, q, exec)
+				f.Body = append(f.Body, body...)
+				f.Returns = returns
 			case ":many":
-				f.Body = append(f.Body,
-					assignNode("result", exec),
-					poet.Node(
-						&pyast.For{
-							Target: poet.Name("row"),
-							Iter:   poet.Name("result"),
-							Body: []*pyast.Node{
-								poet.Expr(
-									poet.Yield(
-										q.Ret.RowNode("row"),
+				if q.ManyAsList {
+					cls.Body = append(cls.Body, poet.Node(&pyast.FunctionDef{
+						Name:    q.MethodName,
+						Args:    f.Args,
+						Body:    wrapMethodBody(ctx.C, q, q.MethodName, q.ConstantName, append(append([]*pyast.Node{}, f.Body...), manyListNodes(q, exec)...), false),
+						Returns: subscriptNode("List", q.Ret.Annotation()),
+					}))
+					f.Name = iterMethodName(q)
+				}
+				if ctx.C.EmitStreamingMany {
+					stream = streamingQuery(ctx.C, q)
+					exec := connMethodNode(ctx.C, "execute", stream.textNode(q.ConstantName), q.ArgDictNode())
+					f.Body = append(f.Body, streamNodes(q, exec)...)
+				} else {
+					f.Body = append(f.Body,
+						assignNode("result", exec),
+						poet.Node(
+							&pyast.For{
+								Target: poet.Name("row"),
+								Iter:   poet.Name("result"),
+								Body: []*pyast.Node{
+									poet.Expr(
+										poet.Yield(
+											q.Ret.RowNode("row"),
+										),
 									),
-								),
+								},
 							},
-						},
-					),
-				)
+						),
+					)
+				}
 				f.Returns = subscriptNode("Iterator", q.Ret.Annotation())
 			case ":exec":
 				f.Body = append(f.Body, exec)
@@ -963,15 +1414,27 @@ func buildQueryTree(ctx *pyTmplCtx, i *importer, source string) *pyast.Node {
 				panic("unknown cmd " + q.Cmd)
 			}
 
+			f.Body = wrapMethodBody(ctx.C, stream, f.Name, q.ConstantName, f.Body, false)
 			cls.Body = append(cls.Body, poet.Node(f))
+			if q.Paginate != nil {
+				cls.Body = append(cls.Body, poet.Node(pageFunctionDef(ctx.C, q)))
+			}
 		}
 		mod.Body = append(mod.Body, poet.Node(cls))
 	}
 
 	if ctx.C.EmitAsyncQuerier {
 		cls := asyncQuerierClassDef()
+		addQueryHooks(ctx.C, cls)
+		addEngineConstructors(ctx.C, cls, true)
+		if ctx.C.EmitEngineConstructors {
+			cls.Body = append(cls.Body, connectionFunctionDef(true))
+		}
+		if ctx.C.EmitTransactionHelpers {
+			cls.Body = append(cls.Body, transactionFunctionDefs(ctx.C, cls.Name, true)...)
+		}
 		for _, q := range ctx.Queries {
-			if !ctx.OutputQuery(q.SourceName) {
+			if !ctx.OutputQuery(q.ModuleName) {
 				continue
 			}
 			f := &pyast.AsyncFunctionDef{
@@ -986,41 +1449,27 @@ func buildQueryTree(ctx *pyTmplCtx, i *importer, source string) *pyast.Node {
 			}
 
 			q.AddArgs(f.Args)
-			exec := connMethodNode("execute", q.ConstantName, q.ArgDictNode())
+			if doc := docstringNode(ctx.C, q); doc != nil {
+				f.Body = append(f.Body, doc)
+			}
+			exec := connMethodNode(ctx.C, "execute", q.textNode(q.ConstantName), q.ArgDictNode())
 
 			switch q.Cmd {
 			case ":one":
-				f.Body = append(f.Body,
-					assignNode("row", poet.Node(
-						&pyast.Call{
-							Func: poet.Attribute(poet.Await(exec), "first"),
-						},
-					)),
-					poet.Node(
-						&pyast.If{
-							Test: poet.Node(
-								&pyast.Compare{
-									Left: poet.Name("row"),
-									Ops: []*pyast.Node{
-										poet.Is(),
-									},
-									Comparators: []*pyast.Node{
-										poet.Constant(nil),
-									},
-								},
-							),
-							Body: []*pyast.Node{
-								poet.Return(
-									poet.Constant(nil),
-								),
-							},
-						},
-					),
-					poet.Return(q.Ret.RowNode("row")),
-				)
-				f.Returns = subscriptNode("Optional", q.Ret.Annotation())
+				body, returns := oneNodes(ctx.C, q, poet.Await(exec))
+				f.Body = append(f.Body, body...)
+				f.Returns = returns
 			case ":many":
-				stream := connMethodNode("stream", q.ConstantName, q.ArgDictNode())
+				if q.ManyAsList {
+					cls.Body = append(cls.Body, poet.Node(&pyast.AsyncFunctionDef{
+						Name:    q.MethodName,
+						Args:    f.Args,
+						Body:    wrapMethodBody(ctx.C, q, q.MethodName, q.ConstantName, append(append([]*pyast.Node{}, f.Body...), manyListNodes(q, poet.Await(exec))...), true),
+						Returns: subscriptNode("List", q.Ret.Annotation()),
+					}))
+					f.Name = iterMethodName(q)
+				}
+				stream := connMethodNode(ctx.C, "stream", q.textNode(q.ConstantName), q.ArgDictNode())
 				f.Body = append(f.Body,
 					assignNode("result", poet.Await(stream)),
 					poet.Node(
@@ -1056,7 +1505,11 @@ func buildQueryTree(ctx *pyTmplCtx, i *importer, source string) *pyast.Node {
 				panic("unknown cmd " + q.Cmd)
 			}
 
+			f.Body = wrapMethodBody(ctx.C, q, f.Name, q.ConstantName, f.Body, true)
 			cls.Body = append(cls.Body, poet.Node(f))
+			if q.Paginate != nil {
+				cls.Body = append(cls.Body, poet.Node(asyncPageFunctionDef(ctx.C, q)))
+			}
 		}
 		mod.Body = append(mod.Body, poet.Node(cls))
 	}
@@ -1064,17 +1517,304 @@ func buildQueryTree(ctx *pyTmplCtx, i *importer, source string) *pyast.Node {
 	return poet.Node(mod)
 }
 
+var invalidModuleChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// sanitizeModuleName turns a file name into a valid Python module name
+func sanitizeModuleName(name string) string {
+	name = invalidModuleChars.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// queryModuleName returns the name of the module generated for a query file.
+// Query files mapped to the same module in query_modules share a module.
+func queryModuleName(conf Config, source string) string {
+	if module, ok := conf.QueryModules[source]; ok {
+		return strings.TrimSuffix(module, ".py")
+	}
+	module := strings.TrimSuffix(strings.TrimSuffix(source, ".py"), ".sql")
+	module = conf.OutputFilesPrefix + module + conf.OutputFilesSuffix
+	if conf.SanitizeModuleNames {
+		module = sanitizeModuleName(module)
+	}
+	return module
+}
+
+// modelsModuleName returns the name of the module the models are emitted in
+func modelsModuleName(conf Config) string {
+	if conf.OutputModelsFileName != "" {
+		return strings.TrimSuffix(conf.OutputModelsFileName, ".py")
+	}
+	return "models"
+}
+
+// importableModuleName checks that a generated module can be imported by
+// another generated module.
+func importableModuleName(module, importer string) (string, error) {
+	if !pyIdentifierPattern.MatchString(module) || pyKeywords[module] {
+		return "", fmt.Errorf("%s: %s is not a valid module name", importer, module)
+	}
+	return module, nil
+}
+
+// checkSharedStructsModule checks that the shared module can be imported by
+// the query modules, and doesn't overwrite the models or a query module.
+func checkSharedStructsModule(conf Config, queries []Query) error {
+	module := conf.SharedStructsModule
+	if !pyIdentifierPattern.MatchString(module) || pyKeywords[module] {
+		return fmt.Errorf("invalid shared_structs_module: %q is not a valid module name", module)
+	}
+	if module == modelsModuleName(conf) {
+		return fmt.Errorf("shared_structs_module: %s conflicts with the models module", module)
+	}
+	for _, q := range queries {
+		if q.ModuleName == module {
+			return fmt.Errorf("shared_structs_module: %s conflicts with the module of %s", module, q.SourceName)
+		}
+	}
+	return nil
+}
+
+const combinedQuerierModule = "querier"
+
+// buildCombinedQuerierTree emits a Querier and AsyncQuerier that inherit from
+// the queriers of every query module, so that a single object exposes all
+// queries of the package.
+func buildCombinedQuerierTree(ctx *pyTmplCtx, queryModules []string) (*pyast.Node, error) {
+	mod := moduleNode(ctx.SqlcVersion, "")
+
+	std := map[string]importSpec{}
+	transactionImports(ctx.C, std)
+	pkg := map[string]importSpec{
+		"sqlalchemy": {Module: "sqlalchemy"},
+	}
+	if ctx.C.EmitAsyncQuerier {
+		pkg["sqlalchemy.ext.asyncio"] = importSpec{Module: "sqlalchemy.ext.asyncio"}
+	}
+	if ctx.C.EmitQueryHooks {
+		std["typing.Optional"] = importSpec{Module: "typing", Name: "Optional"}
+	}
+	if ctx.C.EmitEngineConstructors {
+		// The _connection method is inherited from the query modules
+		std["typing.Union"] = importSpec{Module: "typing", Name: "Union"}
+		if ctx.C.EmitSyncQuerier {
+			pkg["sqlalchemy.orm"] = importSpec{Module: "sqlalchemy.orm"}
+		}
+	}
+	mod.Body = append(mod.Body, buildImportGroup(std), buildImportGroup(pkg))
+
+	var modules []string
+	var names []*pyast.Node
+	for _, name := range queryModules {
+		module, err := importableModuleName(name, combinedQuerierModule+".py")
+		if err != nil {
+			return nil, err
+		}
+		modules = append(modules, module)
+		names = append(names, poet.Alias(module))
+	}
+	if ctx.C.EmitQueryHooks {
+		names = append(names, poet.Alias(instrumentationModule))
+		sort.Slice(names, func(i, j int) bool {
+			return names[i].GetAlias().Name < names[j].GetAlias().Name
+		})
+	}
+	mod.Body = append(mod.Body, &pyast.Node{
+		Node: &pyast.Node_ImportGroup{
+			ImportGroup: &pyast.ImportGroup{
+				Imports: []*pyast.Node{
+					importFromNode(ctx.C.Package, names...),
+				},
+			},
+		},
+	})
+
+	if ctx.C.EmitSyncQuerier {
+		cls := querierClassDef()
+		for _, module := range modules {
+			cls.Bases = append(cls.Bases, typeRefNode(module, "Querier"))
+		}
+		addQueryHooks(ctx.C, cls)
+		addEngineConstructors(ctx.C, cls, false)
+		if ctx.C.EmitTransactionHelpers {
+			cls.Body = append(cls.Body, transactionFunctionDefs(ctx.C, cls.Name, false)...)
+		}
+		mod.Body = append(mod.Body, poet.Node(cls))
+	}
+	if ctx.C.EmitAsyncQuerier {
+		cls := asyncQuerierClassDef()
+		for _, module := range modules {
+			cls.Bases = append(cls.Bases, typeRefNode(module, "AsyncQuerier"))
+		}
+		addQueryHooks(ctx.C, cls)
+		addEngineConstructors(ctx.C, cls, true)
+		if ctx.C.EmitTransactionHelpers {
+			cls.Body = append(cls.Body, transactionFunctionDefs(ctx.C, cls.Name, true)...)
+		}
+		mod.Body = append(mod.Body, poet.Node(cls))
+	}
+	return poet.Node(mod), nil
+}
+
+func importFromNode(module string, names ...*pyast.Node) *pyast.Node {
+	return &pyast.Node{
+		Node: &pyast.Node_ImportFrom{
+			ImportFrom: &pyast.ImportFrom{
+				Module: module,
+				Names:  names,
+			},
+		},
+	}
+}
+
+// buildInitTree re-exports the models, enums and queriers of the package. The
+// queriers of each module are prefixed with the module name when there is
+// more than one query module, e.g. CityQuerier and AsyncCityQuerier, or when
+// the combined queriers are exported as Querier and AsyncQuerier.
+func buildInitTree(ctx *pyTmplCtx, queryModules []string) (*pyast.Node, error) {
+	mod := moduleNode(ctx.SqlcVersion, "")
+
+	var imports []*pyast.Node
+	var exported []string
+	seen := map[string]string{}
+	export := func(name, from string) error {
+		if prev, ok := seen[name]; ok {
+			return fmt.Errorf("__init__.py: %s is exported by both %s and %s", name, prev, from)
+		}
+		seen[name] = from
+		exported = append(exported, name)
+		return nil
+	}
+
+	var modelNames []string
+	for _, e := range ctx.Enums {
+		modelNames = append(modelNames, e.Name)
+	}
+	for _, m := range ctx.Models {
+		modelNames = append(modelNames, m.Name)
+	}
+	if queriesPaginate(ctx.Queries) {
+		modelNames = append(modelNames, pageClass)
+	}
+	sort.Strings(modelNames)
+	var models []*pyast.Node
+	for _, name := range modelNames {
+		models = append(models, poet.Alias(name))
+		if err := export(name, "models"); err != nil {
+			return nil, err
+		}
+	}
+	if len(models) > 0 {
+		module, err := importableModuleName(modelsModuleName(ctx.C), "__init__.py")
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, importFromNode(ctx.C.Package+"."+module, models...))
+	}
+
+	if raisesNoRows(ctx.C) {
+		var names []*pyast.Node
+		for _, name := range []string{"NoRowsError", "TooManyRowsError"} {
+			names = append(names, poet.AliasAs(name, name))
+			if err := export(name, errorsModule); err != nil {
+				return nil, err
+			}
+		}
+		imports = append(imports, importFromNode(ctx.C.Package+"."+errorsModule, names...))
+	}
+
+	if ctx.C.EmitQueryHooks {
+		if err := export(queryHooksClass, instrumentationModule); err != nil {
+			return nil, err
+		}
+		imports = append(imports, importFromNode(ctx.C.Package+"."+instrumentationModule,
+			poet.AliasAs(queryHooksClass, queryHooksClass)))
+	}
+
+	if ctx.C.EmitCombinedQuerier {
+		var names []*pyast.Node
+		if ctx.C.EmitAsyncQuerier {
+			names = append(names, poet.AliasAs("AsyncQuerier", "AsyncQuerier"))
+			if err := export("AsyncQuerier", combinedQuerierModule); err != nil {
+				return nil, err
+			}
+		}
+		if ctx.C.EmitSyncQuerier {
+			names = append(names, poet.AliasAs("Querier", "Querier"))
+			if err := export("Querier", combinedQuerierModule); err != nil {
+				return nil, err
+			}
+		}
+		if len(names) > 0 {
+			imports = append(imports, importFromNode(ctx.C.Package+"."+combinedQuerierModule, names...))
+		}
+	}
+
+	for _, name := range queryModules {
+		module, err := importableModuleName(name, "__init__.py")
+		if err != nil {
+			return nil, err
+		}
+		prefix := ""
+		if len(queryModules) > 1 || ctx.C.EmitCombinedQuerier {
+			prefix = modelName(module, nil)
+			if prefix != "" && prefix[0] >= '0' && prefix[0] <= '9' {
+				prefix = "_" + prefix
+			}
+		}
+		var names []*pyast.Node
+		if ctx.C.EmitAsyncQuerier {
+			name := "Async" + prefix + "Querier"
+			names = append(names, poet.AliasAs("AsyncQuerier", name))
+			if err := export(name, module); err != nil {
+				return nil, err
+			}
+		}
+		if ctx.C.EmitSyncQuerier {
+			name := prefix + "Querier"
+			names = append(names, poet.AliasAs("Querier", name))
+			if err := export(name, module); err != nil {
+				return nil, err
+			}
+		}
+		if len(names) > 0 {
+			imports = append(imports, importFromNode(ctx.C.Package+"."+module, names...))
+		}
+	}
+	sort.SliceStable(imports, func(i, j int) bool {
+		return imports[i].GetImportFrom().Module < imports[j].GetImportFrom().Module
+	})
+	mod.Body = append(mod.Body, &pyast.Node{
+		Node: &pyast.Node_ImportGroup{
+			ImportGroup: &pyast.ImportGroup{
+				Imports: imports,
+			},
+		},
+	})
+
+	sort.Strings(exported)
+	var all []*pyast.Node
+	for _, name := range exported {
+		all = append(all, poet.Constant(name))
+	}
+	mod.Body = append(mod.Body, assignNode("__all__", poet.List(all...)))
+	return poet.Node(mod), nil
+}
+
 type pyTmplCtx struct {
-	SqlcVersion string
-	Models      []Struct
-	Queries     []Query
-	Enums       []Enum
-	SourceName  string
-	C           Config
+	SqlcVersion   string
+	Models        []Struct
+	Queries       []Query
+	Enums         []Enum
+	SharedStructs []*Struct
+	ModuleName    string
+	C             Config
 }
 
-func (t *pyTmplCtx) OutputQuery(sourceName string) bool {
-	return t.SourceName == sourceName
+func (t *pyTmplCtx) OutputQuery(moduleName string) bool {
+	return t.ModuleName == moduleName
 }
 
 func HashComment(s string) string {
@@ -1089,56 +1829,156 @@ func Generate(_ context.Context, req *plugin.GenerateRequest) (*plugin.GenerateR
 		}
 	}
 
-	enums := buildEnums(req)
+	if !validColumnComments(conf.ColumnComments) {
+		return nil, fmt.Errorf("invalid column_comments: %q", conf.ColumnComments)
+	}
+	if conf.LineLength < 0 {
+		return nil, errors.New("invalid line length")
+	}
+	if conf.StreamYieldPer < 0 {
+		return nil, errors.New("invalid stream_yield_per")
+	}
+
+	enums, err := buildEnums(conf, req)
+	if err != nil {
+		return nil, err
+	}
 	models := buildModels(conf, req)
-	queries, err := buildQueries(conf, req, models)
+	queries, projs, err := buildQueries(conf, req, models)
 	if err != nil {
 		return nil, err
 	}
+	if len(projs) > 0 {
+		models = append(models, projs...)
+		sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+	}
+	if queriesPaginate(queries) {
+		if err := checkPageNames(models, enums); err != nil {
+			return nil, err
+		}
+	}
+	if conf.SharedStructsModule != "" {
+		if err := checkSharedStructsModule(conf, queries); err != nil {
+			return nil, err
+		}
+	}
+	var shared []*Struct
+	if conf.DedupeStructs || conf.SharedStructsModule != "" {
+		shared, err = dedupeStructs(conf, queries)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	i := &importer{
-		Models:  models,
-		Queries: queries,
-		Enums:   enums,
-		C:       conf,
+		Models:        models,
+		Queries:       queries,
+		Enums:         enums,
+		SharedStructs: shared,
+		C:             conf,
 	}
 
 	tctx := pyTmplCtx{
-		Models:      models,
-		Queries:     queries,
-		Enums:       enums,
-		SqlcVersion: req.SqlcVersion,
-		C:           conf,
+		Models:        models,
+		Queries:       queries,
+		Enums:         enums,
+		SharedStructs: shared,
+		SqlcVersion:   req.SqlcVersion,
+		C:             conf,
 	}
 
-	output := map[string]string{}
-	result := pyprint.Print(buildModelsTree(&tctx, i), pyprint.Options{})
-	tctx.SourceName = "models.py"
-	output["models.py"] = string(result.Python)
+	output := map[string]*pyast.Node{}
+	output[modelsModuleName(conf)+".py"] = buildModelsTree(&tctx, i)
 
-	files := map[string]struct{}{}
+	if len(shared) > 0 {
+		name := conf.SharedStructsModule + ".py"
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("output file %s already exists", name)
+		}
+		output[name] = buildSharedStructsTree(&tctx, i)
+	}
+
+	if raisesNoRows(conf) {
+		name := errorsModule + ".py"
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("output file %s already exists", name)
+		}
+		output[name] = buildErrorsTree(&tctx)
+	}
+
+	if conf.EmitQueryHooks {
+		name := instrumentationModule + ".py"
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("output file %s already exists", name)
+		}
+		output[name] = buildInstrumentationTree(&tctx)
+	}
+
+	// Query files mapped to the same module are generated together
+	sources := map[string][]string{}
+	seen := map[string]bool{}
 	for _, q := range queries {
-		files[q.SourceName] = struct{}{}
+		if !seen[q.SourceName] {
+			seen[q.SourceName] = true
+			sources[q.ModuleName] = append(sources[q.ModuleName], q.SourceName)
+		}
+	}
+	var modules []string
+	for module := range sources {
+		modules = append(modules, module)
+		sort.Strings(sources[module])
 	}
+	sort.Strings(modules)
 
-	for source := range files {
-		tctx.SourceName = source
-		result := pyprint.Print(buildQueryTree(&tctx, i, source), pyprint.Options{})
-		name := source
-		if !strings.HasSuffix(name, ".py") {
-			name = strings.TrimSuffix(name, ".sql")
-			name += ".py"
+	if conf.EmitInit {
+		tree, err := buildInitTree(&tctx, modules)
+		if err != nil {
+			return nil, err
 		}
-		output[name] = string(result.Python)
+		output["__init__.py"] = tree
+	}
+
+	for _, module := range modules {
+		tctx.ModuleName = module
+		name := module + ".py"
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("%s: output file %s already exists", strings.Join(sources[module], ", "), name)
+		}
+		output[name] = buildQueryTree(&tctx, i, module, sources[module])
+	}
+
+	if conf.EmitCombinedQuerier {
+		tree, err := buildCombinedQuerierTree(&tctx, modules)
+		if err != nil {
+			return nil, err
+		}
+		name := combinedQuerierModule + ".py"
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("output file %s already exists", name)
+		}
+		output[name] = tree
 	}
 
 	resp := plugin.GenerateResponse{}
 
-	for filename, code := range output {
+	opts := pyprint.Options{
+		LineLength:             conf.LineLength,
+		SkipMagicTrailingComma: conf.SkipMagicTrailingComma,
+		RawStrings:             conf.RawStrings,
+	}
+	for filename, tree := range output {
+		result := pyprint.Print(tree, opts)
 		resp.Files = append(resp.Files, &plugin.File{
 			Name:     filename,
-			Contents: []byte(code),
+			Contents: result.Python,
 		})
+		if conf.EmitStubs {
+			result := pyprint.Print(stubTree(tree), opts)
+			resp.Files = append(resp.Files, &plugin.File{
+				Name:     strings.TrimSuffix(filename, ".py") + ".pyi",
+				Contents: result.Python,
+			})
+		}
 	}
 
 	return &resp, nil
@@ -26,18 +26,22 @@ func (i importSpec) String() string {
 }
 
 type importer struct {
-	Models  []Struct
-	Queries []Query
-	Enums   []Enum
-	C       Config
+	Models        []Struct
+	Queries       []Query
+	Enums         []Enum
+	SharedStructs []*Struct
+	C             Config
 }
 
 func structUses(name string, s Struct) bool {
 	for _, f := range s.Fields {
-		if name == "typing.List" && f.Type.IsArray {
+		if name == "typing.List" && f.Type.IsArray() {
 			return true
 		}
-		if name == "typing.Optional" && f.Type.IsNull {
+		if name == "typing.Optional" && (f.Type.IsNull || f.Type.IsElementNull) {
+			return true
+		}
+		if name == "typing.Annotated" && f.Comment != "" {
 			return true
 		}
 		if f.Type.InnerType == name {
@@ -47,12 +51,24 @@ func structUses(name string, s Struct) bool {
 	return false
 }
 
+// columnCommentImports adds the imports needed to emit column comments as
+// Annotated metadata.
+func (i *importer) columnCommentImports(std, pkg map[string]importSpec, uses func(name string) bool) {
+	if i.C.ColumnComments != columnCommentsAnnotated || !uses("typing.Annotated") {
+		return
+	}
+	std["typing.Annotated"] = importSpec{Module: "typing", Name: "Annotated"}
+	if !i.C.EmitPydanticModels {
+		pkg["typing_extensions.Doc"] = importSpec{Module: "typing_extensions", Name: "Doc"}
+	}
+}
+
 func queryValueUses(name string, qv QueryValue) bool {
 	if !qv.isEmpty() {
-		if name == "typing.List" && qv.Typ.IsArray {
+		if name == "typing.List" && qv.Typ.IsArray() {
 			return true
 		}
-		if name == "typing.Optional" && qv.Typ.IsNull {
+		if name == "typing.Optional" && (qv.Typ.IsNull || qv.Typ.IsElementNull) {
 			return true
 		}
 		if qv.IsStruct() && qv.EmitStruct() {
@@ -94,12 +110,81 @@ func (i *importer) modelImportSpecs() (map[string]importSpec, map[string]importS
 	if len(i.Enums) > 0 {
 		std["enum"] = importSpec{Module: "enum"}
 	}
+	if queriesPaginate(i.Queries) {
+		for _, name := range []string{"Generic", "List", "Optional", "TypeVar"} {
+			std["typing."+name] = importSpec{Module: "typing", Name: name}
+		}
+	}
 
 	pkg := make(map[string]importSpec)
+	i.columnCommentImports(std, pkg, modelUses)
+	for n := range i.Models {
+		if returnsStruct(i.C, i.Queries, &i.Models[n]) {
+			pkg["sqlalchemy"] = importSpec{Module: "sqlalchemy"}
+		}
+	}
 
 	return std, pkg
 }
 
+func (i *importer) sharedStructImportSpecs() (map[string]importSpec, map[string]importSpec) {
+	sharedUses := func(name string) bool {
+		for _, s := range i.SharedStructs {
+			if structUses(name, *s) {
+				return true
+			}
+		}
+		return false
+	}
+
+	std := stdImports(sharedUses)
+	if i.C.EmitPydanticModels {
+		std["pydantic"] = importSpec{Module: "pydantic"}
+	} else {
+		std["dataclasses"] = importSpec{Module: "dataclasses"}
+	}
+
+	pkg := make(map[string]importSpec)
+	i.columnCommentImports(std, pkg, sharedUses)
+	for _, s := range i.SharedStructs {
+		if returnsStruct(i.C, i.Queries, s) {
+			pkg["sqlalchemy"] = importSpec{Module: "sqlalchemy"}
+		}
+	}
+
+	return std, pkg
+}
+
+// sharedStructsUseModels reports whether any shared class has a field with a
+// type defined in the models module, such as an enum.
+func (i *importer) sharedStructsUseModels() bool {
+	for _, s := range i.SharedStructs {
+		for _, f := range s.Fields {
+			if strings.HasPrefix(f.Type.InnerType, "models.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (i *importer) queryUsesSharedStructs(module string) bool {
+	for _, q := range i.Queries {
+		if q.ModuleName != module {
+			continue
+		}
+		if q.Ret.IsStruct() && q.Ret.Struct.Module != "" {
+			return true
+		}
+		for _, arg := range q.Args {
+			if arg.IsStruct() && arg.Struct.Module != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (i *importer) modelImports() []string {
 	std, pkg := i.modelImportSpecs()
 	importLines := []string{
@@ -110,10 +195,10 @@ func (i *importer) modelImports() []string {
 	return importLines
 }
 
-func (i *importer) queryImportSpecs(fileName string) (map[string]importSpec, map[string]importSpec) {
+func (i *importer) queryImportSpecs(module string) (map[string]importSpec, map[string]importSpec) {
 	queryUses := func(name string) bool {
 		for _, q := range i.Queries {
-			if q.SourceName != fileName {
+			if q.ModuleName != module {
 				continue
 			}
 			if queryValueUses(name, q.Ret) {
@@ -135,6 +220,12 @@ func (i *importer) queryImportSpecs(fileName string) (map[string]importSpec, map
 	if i.C.EmitAsyncQuerier {
 		pkg["sqlalchemy.ext.asyncio"] = importSpec{Module: "sqlalchemy.ext.asyncio"}
 	}
+	i.columnCommentImports(std, pkg, queryUses)
+	transactionImports(i.C, std)
+	engineImports(i.C, std, pkg)
+	if i.C.EmitQueryHooks {
+		std["typing.Optional"] = importSpec{Module: "typing", Name: "Optional"}
+	}
 
 	queryValueModelImports := func(qv QueryValue) {
 		if qv.IsStruct() && qv.EmitStruct() {
@@ -147,13 +238,19 @@ func (i *importer) queryImportSpecs(fileName string) (map[string]importSpec, map
 	}
 
 	for _, q := range i.Queries {
-		if q.SourceName != fileName {
+		if q.ModuleName != module {
 			continue
 		}
-		if q.Cmd == ":one" {
+		if q.Paginate != nil && q.Paginate.CursorType.IsNull {
+			std["typing.Optional"] = importSpec{Module: "typing", Name: "Optional"}
+		}
+		if q.Cmd == ":one" && !raisesNoRows(i.C) {
 			std["typing.Optional"] = importSpec{Module: "typing", Name: "Optional"}
 		}
 		if q.Cmd == ":many" {
+			if q.ManyAsList {
+				std["typing.List"] = importSpec{Module: "typing", Name: "List"}
+			}
 			if i.C.EmitSyncQuerier {
 				std["typing.Iterator"] = importSpec{Module: "typing", Name: "Iterator"}
 			}
@@ -0,0 +1,191 @@
+package python
+
+import (
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+const (
+	instrumentationModule = "instrumentation"
+	queryHooksClass       = "QueryHooks"
+	hooksArg              = "hooks"
+)
+
+func strArg(name string) *pyast.Arg {
+	return &pyast.Arg{Arg: name, Annotation: poet.Name("str")}
+}
+
+func docNode(doc string) *pyast.Node {
+	return poet.Expr(poet.Constant(doc))
+}
+
+// buildInstrumentationTree emits the hooks run around each query of the
+// generated queriers
+func buildInstrumentationTree(ctx *pyTmplCtx) *pyast.Node {
+	mod := moduleNode(ctx.SqlcVersion, "")
+	mod.Body = append(mod.Body, buildImportGroup(map[string]importSpec{
+		"contextlib":            {Module: "contextlib"},
+		"time":                  {Module: "time"},
+		"typing.ContextManager": {Module: "typing", Name: "ContextManager"},
+		"typing.Iterator":       {Module: "typing", Name: "Iterator"},
+		"typing.Optional":       {Module: "typing", Name: "Optional"},
+	}))
+
+	self := &pyast.Arg{Arg: "self"}
+	callHook := func(hook string, extra ...*pyast.Node) *pyast.Node {
+		return poet.Expr(poet.Node(&pyast.Call{
+			Func: poet.Attribute(poet.Name("self"), hook),
+			Args: append([]*pyast.Node{poet.Name("name"), poet.Name("sql")}, extra...),
+		}))
+	}
+	perfCounter := poet.Node(&pyast.Call{Func: typeRefNode("time", "perf_counter")})
+	mod.Body = append(mod.Body, poet.Node(&pyast.ClassDef{
+		Name: queryHooksClass,
+		Body: []*pyast.Node{
+			docNode("Callbacks run around each query of a querier.\n\n" +
+				"Subclasses override before_query and after_query, or query to wrap\n" +
+				"queries in a context manager such as an OpenTelemetry span."),
+			poet.Node(&pyast.FunctionDef{
+				Name:    "before_query",
+				Args:    &pyast.Arguments{Args: []*pyast.Arg{self, strArg("name"), strArg("sql")}},
+				Returns: poet.Constant(nil),
+				Body:    []*pyast.Node{docNode("Called before a query runs.")},
+			}),
+			poet.Node(&pyast.FunctionDef{
+				Name: "after_query",
+				Args: &pyast.Arguments{Args: []*pyast.Arg{
+					self, strArg("name"), strArg("sql"),
+					{Arg: "duration", Annotation: poet.Name("float")},
+				}},
+				Returns: poet.Constant(nil),
+				Body:    []*pyast.Node{docNode("Called after a query ran or failed, with its duration in seconds.")},
+			}),
+			poet.Node(&pyast.FunctionDef{
+				Name:          "query",
+				DecoratorList: []*pyast.Node{typeRefNode("contextlib", "contextmanager")},
+				Args:          &pyast.Arguments{Args: []*pyast.Arg{self, strArg("name"), strArg("sql")}},
+				Returns:       subscriptNode("Iterator", poet.Constant(nil)),
+				Body: []*pyast.Node{
+					docNode("Runs around a query, calling before_query and after_query."),
+					callHook("before_query"),
+					assignNode("start", perfCounter),
+					poet.Node(&pyast.Try{
+						Body: []*pyast.Node{poet.Expr(poet.Yield(nil))},
+						FinalBody: []*pyast.Node{
+							callHook("after_query", poet.BinOp(perfCounter, &pyast.Sub{}, poet.Name("start"))),
+						},
+					}),
+				},
+			}),
+		},
+	}))
+
+	mod.Body = append(mod.Body, poet.Node(&pyast.FunctionDef{
+		Name: "run_query",
+		Args: &pyast.Arguments{Args: []*pyast.Arg{
+			{Arg: hooksArg, Annotation: subscriptNode("Optional", poet.Name(queryHooksClass))},
+			strArg("name"),
+			strArg("sql"),
+		}},
+		Returns: subscriptNode("ContextManager", poet.Constant(nil)),
+		Body: []*pyast.Node{
+			docNode("Returns the context manager run around a query."),
+			poet.Node(&pyast.If{
+				Test: poet.Node(&pyast.Compare{
+					Left:        poet.Name(hooksArg),
+					Ops:         []*pyast.Node{poet.Is()},
+					Comparators: []*pyast.Node{poet.Constant(nil)},
+				}),
+				Body: []*pyast.Node{poet.Return(poet.Node(&pyast.Call{
+					Func: typeRefNode("contextlib", "nullcontext"),
+				}))},
+			}),
+			poet.Return(poet.Node(&pyast.Call{
+				Func: poet.Attribute(poet.Name(hooksArg), "query"),
+				Args: []*pyast.Node{poet.Name("name"), poet.Name("sql")},
+			})),
+		},
+	}))
+	return poet.Node(mod)
+}
+
+func hooksAnnotation() *pyast.Node {
+	return subscriptNode("Optional", typeRefNode(instrumentationModule, queryHooksClass))
+}
+
+// addQueryHooks adds the optional hooks argument to the constructor of the
+// querier class cls
+func addQueryHooks(conf Config, cls *pyast.ClassDef) {
+	if !conf.EmitQueryHooks {
+		return
+	}
+	init := cls.Body[0].GetFunctionDef()
+	init.Args.Args = append(init.Args.Args, &pyast.Arg{Arg: hooksArg, Annotation: hooksAnnotation()})
+	init.Args.Defaults = append(init.Args.Defaults, poet.Constant(nil))
+	init.Body = append(init.Body, poet.Node(&pyast.Assign{
+		Targets: []*pyast.Node{poet.Attribute(poet.Name("self"), "_hooks")},
+		Value:   poet.Name(hooksArg),
+	}))
+}
+
+// wrapMethodBody runs the statements of a querier method, after its
+// docstring, in the query hooks and on the connection checked out for the
+// call. The statements setting the execution options of the query, if any,
+// run first.
+//
+// The hooks of generators only run around the statements executing the
+// query, not around the loop yielding its rows: a context such as a span
+// must not stay current in the caller while it iterates.
+func wrapMethodBody(conf Config, q Query, name, constant string, body []*pyast.Node, async bool) []*pyast.Node {
+	var wrapped []*pyast.Node
+	if len(body) > 0 && body[0].GetExpr().GetValue().GetConstant().GetStr() != "" {
+		wrapped, body = body[:1], body[1:]
+	}
+	before, after := q.executionOptionNodes(conf, constant, async)
+	if len(after) > 0 && len(body) > 0 {
+		body = resetAfterExecute(body, after)
+	}
+	body = append(before, body...)
+	var items []*pyast.WithItem
+	if conf.EmitQueryHooks {
+		hooks := &pyast.WithItem{
+			ContextExpr: poet.Node(&pyast.Call{
+				Func: typeRefNode(instrumentationModule, "run_query"),
+				Args: []*pyast.Node{
+					poet.Attribute(poet.Name("self"), "_hooks"),
+					poet.Constant(name),
+					poet.Name(constant),
+				},
+			}),
+		}
+		loop := 0
+		for loop < len(body) && !containsYield(body[loop:loop+1]) {
+			loop++
+		}
+		if 0 < loop && loop < len(body) {
+			body = append([]*pyast.Node{poet.Node(&pyast.With{
+				Items: []*pyast.WithItem{hooks},
+				Body:  body[:loop],
+			})}, body[loop:]...)
+		} else {
+			items = append(items, hooks)
+		}
+	}
+	if conf.EmitEngineConstructors {
+		conn := &pyast.WithItem{
+			ContextExpr:  poet.Node(&pyast.Call{Func: poet.Attribute(poet.Name("self"), connectionMethod)}),
+			OptionalVars: poet.Name(connVar),
+		}
+		if !async {
+			items = append(items, conn)
+		} else {
+			// The connection is checked out by an async context manager,
+			// which can't share a statement with the hooks
+			body = []*pyast.Node{poet.Node(&pyast.AsyncWith{Items: []*pyast.WithItem{conn}, Body: body})}
+		}
+	}
+	if len(items) > 0 {
+		body = []*pyast.Node{poet.Node(&pyast.With{Items: items, Body: body})}
+	}
+	return append(wrapped, body...)
+}
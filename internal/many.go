@@ -0,0 +1,74 @@
+package python
+
+import (
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// Values of the @many annotation
+const (
+	manyList     = "list"
+	manyIterator = "iterator"
+)
+
+// defaultStreamYieldPer is the number of rows fetched at a time by streaming
+// :many methods
+const defaultStreamYieldPer = 1000
+
+// iterMethodName returns the name of the streaming method of a :many query
+// returning a list.
+func iterMethodName(q Query) string {
+	return q.MethodName + "_iter"
+}
+
+// manyListNodes returns the statements of a :many method collecting the rows
+// of result, an execute call or its awaited value, in a list. The result is
+// fully fetched, so the cursor is released when the method returns.
+func manyListNodes(q Query, result *pyast.Node) []*pyast.Node {
+	return []*pyast.Node{
+		assignNode("result", result),
+		poet.Return(poet.Node(&pyast.ListComp{
+			Elt: q.Ret.RowNode("row"),
+			Generators: []*pyast.Comprehension{
+				{
+					Target: poet.Name("row"),
+					Iter:   poet.Name("result"),
+				},
+			},
+		})),
+	}
+}
+
+// streamingQuery returns q with the execution options of a sync :many method
+// streaming its rows from a server-side cursor. Options set on the query take
+// precedence.
+func streamingQuery(conf Config, q Query) Query {
+	yieldPer := conf.StreamYieldPer
+	if yieldPer == 0 {
+		yieldPer = defaultStreamYieldPer
+	}
+	q.ExecutionOptions = mergeExecutionOptions([]executionOption{
+		{Key: "stream_results", Value: true},
+		{Key: "yield_per", Value: yieldPer},
+	}, q.ExecutionOptions)
+	return q
+}
+
+// streamNodes returns the statements of a sync :many method streaming the
+// rows of result. The result is closed, releasing the cursor, when the
+// iterator is closed before all rows are read.
+func streamNodes(q Query, result *pyast.Node) []*pyast.Node {
+	return []*pyast.Node{
+		assignNode("result", result),
+		poet.Node(&pyast.With{
+			Items: []*pyast.WithItem{{ContextExpr: poet.Name("result")}},
+			Body: []*pyast.Node{
+				poet.Node(&pyast.For{
+					Target: poet.Name("row"),
+					Iter:   poet.Name("result"),
+					Body:   []*pyast.Node{poet.Expr(poet.Yield(q.Ret.RowNode("row")))},
+				}),
+			},
+		}),
+	}
+}
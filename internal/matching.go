@@ -0,0 +1,174 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+	"github.com/sqlc-dev/plugin-sdk-go/sdk"
+)
+
+const (
+	modelMatchingExact     = "exact"
+	modelMatchingUnordered = "unordered"
+)
+
+func validModelMatching(strategy string) bool {
+	switch strategy {
+	case "", modelMatchingExact, modelMatchingUnordered:
+		return true
+	}
+	return false
+}
+
+// modelColumn is a query column as it would appear as a field of a model
+type modelColumn struct {
+	name string
+	typ  pyType
+	col  *plugin.Column
+}
+
+func modelColumns(conf Config, req *plugin.GenerateRequest, columns []*plugin.Column) []modelColumn {
+	mcs := make([]modelColumn, len(columns))
+	for i, c := range columns {
+		// HACK: models do not have "models." on their types, so trim that so we can find matches
+		typ := makePyType(conf, req, c)
+		typ.InnerType = strings.TrimPrefix(typ.InnerType, "models.")
+		mcs[i] = modelColumn{
+			name: columnName(conf, c, i),
+			typ:  typ,
+			col:  c,
+		}
+	}
+	return mcs
+}
+
+func (mc modelColumn) matches(req *plugin.GenerateRequest, s *Struct, f Field) bool {
+	return f.Name == mc.name && f.Type == mc.typ && sdk.SameTableName(mc.col.Table, s.Table, req.Catalog.DefaultSchema)
+}
+
+// findColumn returns the position of the column matching the field, or -1
+func findColumn(req *plugin.GenerateRequest, s *Struct, f Field, columns []modelColumn) int {
+	for i, mc := range columns {
+		if mc.matches(req, s, f) {
+			return i
+		}
+	}
+	return -1
+}
+
+// uniqueColumnNames reports whether a field can be found by name, which is
+// not the case when a query selects the same column more than once.
+func uniqueColumnNames(columns []modelColumn) bool {
+	seen := map[string]bool{}
+	for _, mc := range columns {
+		if seen[mc.name] {
+			return false
+		}
+		seen[mc.name] = true
+	}
+	return true
+}
+
+// matchModel finds the model with the same fields as the columns of a query.
+//
+// With the unordered strategy the columns may be selected in any order. The
+// returned positions then hold the index in the result row of each field, or
+// nil if the fields and columns are in the same order.
+func matchModel(conf Config, req *plugin.GenerateRequest, structs []Struct, columns []modelColumn) (*Struct, []int) {
+	for i := range structs {
+		s := &structs[i]
+		if len(s.Fields) != len(columns) {
+			continue
+		}
+		same := true
+		for j, f := range s.Fields {
+			if !columns[j].matches(req, s, f) {
+				same = false
+				break
+			}
+		}
+		if same {
+			return s, nil
+		}
+	}
+
+	if conf.ModelMatching != modelMatchingUnordered || !uniqueColumnNames(columns) {
+		return nil, nil
+	}
+	for i := range structs {
+		s := &structs[i]
+		if len(s.Fields) != len(columns) {
+			continue
+		}
+		positions := make([]int, len(s.Fields))
+		for j, f := range s.Fields {
+			positions[j] = findColumn(req, s, f, columns)
+			if positions[j] < 0 {
+				positions = nil
+				break
+			}
+		}
+		if positions != nil {
+			return s, positions
+		}
+	}
+	return nil, nil
+}
+
+// projectModel returns a class for a query that selects some of the columns of
+// a single model. Projections are named after the model and the selected
+// columns, so queries selecting the same columns share a projection.
+func projectModel(req *plugin.GenerateRequest, structs []Struct, columns []modelColumn) *Struct {
+	if !uniqueColumnNames(columns) {
+		return nil
+	}
+	for i := range structs {
+		s := &structs[i]
+		if len(columns) >= len(s.Fields) {
+			continue
+		}
+		p := Struct{
+			Table: s.Table,
+			Name:  s.Name,
+		}
+		for _, mc := range columns {
+			found := false
+			for _, f := range s.Fields {
+				if mc.matches(req, s, f) {
+					p.Name += modelName(f.Name, req.Settings)
+					p.Fields = append(p.Fields, f)
+					found = true
+					break
+				}
+			}
+			if !found {
+				p.Fields = nil
+				break
+			}
+		}
+		if p.Fields != nil {
+			return &p
+		}
+	}
+	return nil
+}
+
+// projections collects the projection classes emitted in the models module
+type projections map[string]*Struct
+
+func (p projections) add(s *Struct, models []Struct) (*Struct, error) {
+	if prev, ok := p[s.Name]; ok {
+		if !sameFields(prev.Fields, s.Fields) {
+			return nil, fmt.Errorf("projection %s is used for different columns", s.Name)
+		}
+		return prev, nil
+	}
+	for i := range models {
+		if models[i].Name == s.Name {
+			return nil, fmt.Errorf("projection %s conflicts with the model of the same name", s.Name)
+		}
+	}
+	p[s.Name] = s
+	return s, nil
+}
@@ -0,0 +1,92 @@
+package python
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// pyNameInvalidPattern matches the characters that can't be used in a Python
+// name. Unlike enum member names, names can contain non-ASCII letters.
+var pyNameInvalidPattern = regexp.MustCompile(`[^\p{L}\p{N}_]+`)
+
+// pyKeywords are the reserved words of Python, which can't be used as names
+var pyKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true,
+	"assert": true, "async": true, "await": true, "break": true, "class": true,
+	"continue": true, "def": true, "del": true, "elif": true, "else": true,
+	"except": true, "finally": true, "for": true, "from": true, "global": true,
+	"if": true, "import": true, "in": true, "is": true, "lambda": true,
+	"nonlocal": true, "not": true, "or": true, "pass": true, "raise": true,
+	"return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// escapeKeyword appends an underscore to names that are Python keywords
+func escapeKeyword(name string) string {
+	if pyKeywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// reservedArgNames are the names used in the body of the querier methods,
+// which a parameter of the same name would shadow
+var reservedArgNames = map[string]bool{
+	"self":             true,
+	"conn":             true,
+	"stmt":             true,
+	"result":           true,
+	"row":              true,
+	"rows":             true,
+	"items":            true,
+	"next_cursor":      true,
+	"len":              true,
+	"sqlalchemy":       true,
+	"models":           true,
+	"errors":           true,
+	"instrumentation":  true,
+	"previous_timeout": true,
+}
+
+// checkRename checks that the names of the rename option are valid names
+func checkRename(conf Config) error {
+	var names []string
+	for name := range conf.Rename {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if to := conf.Rename[name]; !pyIdentifierPattern.MatchString(to) {
+			return fmt.Errorf("invalid rename: %s: %q is not a valid name", name, to)
+		}
+	}
+	return nil
+}
+
+// identifierName returns the name of the field or parameter for a column.
+// Columns are renamed with the rename option, if set; otherwise characters
+// that can't be used in a name, like spaces, are replaced by underscores and
+// names starting with a digit are prefixed with column_. Python keywords get
+// a trailing underscore, such as class_.
+func identifierName(conf Config, column string) string {
+	if to, ok := conf.Rename[column]; ok {
+		return escapeKeyword(to)
+	}
+	name := pyNameInvalidPattern.ReplaceAllString(column, "_")
+	if r, _ := utf8.DecodeRuneInString(name); unicode.IsDigit(r) {
+		name = "column_" + name
+	}
+	return escapeKeyword(name)
+}
+
+// argName returns the name of the parameter of a querier method for a
+// column, which must not shadow the names used in the method body
+func argName(conf Config, column string) string {
+	name := identifierName(conf, column)
+	if reservedArgNames[name] {
+		return name + "_"
+	}
+	return name
+}
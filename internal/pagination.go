@@ -0,0 +1,336 @@
+package python
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// Values of the @paginate annotation
+const (
+	paginateKeyset = "keyset"
+	paginateOffset = "offset"
+)
+
+const pageClass = "Page"
+
+// The type variables of the Page class, for the rows and the cursor
+const (
+	pageItemVar   = "T"
+	pageCursorVar = "C"
+)
+
+// pageNames are the names the models module defines for the paging methods,
+// which models and enums must not use
+var pageNames = []string{pageClass, pageItemVar, pageCursorVar}
+
+var keysetPattern = regexp.MustCompile(`^keyset\(\s*([A-Za-z_][A-Za-z0-9_]*|"(?:[^"]|"")+")\s*\)$`)
+
+// parsePaginate parses the value of the @paginate annotation, either
+// "offset" or "keyset(column)". Columns whose name isn't a plain identifier
+// are written in double quotes, as in keyset("first name").
+func parsePaginate(value string) (mode, key string, err error) {
+	if value == paginateOffset {
+		return paginateOffset, "", nil
+	}
+	if m := keysetPattern.FindStringSubmatch(value); m != nil {
+		key := m[1]
+		if strings.HasPrefix(key, `"`) {
+			key = strings.ReplaceAll(key[1:len(key)-1], `""`, `"`)
+		}
+		return paginateKeyset, key, nil
+	}
+	return "", "", fmt.Errorf("@paginate: invalid value %q", value)
+}
+
+// pagination describes the paging method of a :many query
+type pagination struct {
+	Mode string
+	// KeyField is the field of the row class holding the keyset column,
+	// empty if the query returns the column alone
+	KeyField string
+	// KeySQL is the keyset column quoted for the engine
+	KeySQL string
+	// CursorType is the type of the cursor passed to the paging method
+	CursorType pyType
+}
+
+func buildPagination(conf Config, q Query, engine string) (*pagination, error) {
+	if q.Annotations.Paginate == paginateOffset {
+		return &pagination{
+			Mode:       paginateOffset,
+			CursorType: pyType{InnerType: "int"},
+		}, nil
+	}
+	// The fields are named after the columns the same way as the
+	// key column
+	key := identifierName(conf, q.Annotations.PaginateKey)
+	p := &pagination{
+		Mode:   paginateKeyset,
+		KeySQL: quoteIdentifier(q.Annotations.PaginateKey, engine),
+	}
+	switch {
+	case q.Ret.IsStruct():
+		for _, f := range q.Ret.Struct.Fields {
+			if f.Name == key {
+				p.KeyField = f.Name
+				p.CursorType = f.Type
+			}
+		}
+	case q.Ret.Name == key:
+		p.CursorType = q.Ret.Typ
+	}
+	if p.CursorType == (pyType{}) {
+		return nil, fmt.Errorf("@paginate: unknown column %q", q.Annotations.PaginateKey)
+	}
+	// The first page is requested without a cursor
+	p.CursorType.IsNull = true
+	return p, nil
+}
+
+// Arguments added to the paging methods
+const (
+	pageSizeArg = "page_size"
+	cursorArg   = "cursor"
+)
+
+// checkPaginate checks that the paging arguments don't clash with the
+// keyword arguments of the query.
+func (q Query) checkPaginate() error {
+	for _, a := range q.Args {
+		if a.IsStruct() {
+			continue
+		}
+		if name := a.Name; name == pageSizeArg || name == cursorArg {
+			return fmt.Errorf("@paginate: parameter %q conflicts with the paging arguments", name)
+		}
+	}
+	return nil
+}
+
+func pageMethodName(q Query) string {
+	return q.MethodName + "_page"
+}
+
+func pageConstantName(q Query) string {
+	return q.ConstantName + "_PAGE"
+}
+
+// pageSQL wraps the query in a subquery that returns a page of its rows. One
+// more row than the page size is requested, to tell if there is a next page.
+func pageSQL(q Query) string {
+	sql := "SELECT * FROM (\n" + q.SQL + "\n) AS page\n"
+	if q.Paginate.Mode == paginateKeyset {
+		key := "page." + q.Paginate.KeySQL
+		sql += "WHERE :page_cursor IS NULL OR " + key + " > :page_cursor\n"
+		sql += "ORDER BY " + key + "\n"
+		return sql + "LIMIT :page_limit"
+	}
+	return sql + "LIMIT :page_limit OFFSET :page_cursor"
+}
+
+func pageAnnotation(q Query) *pyast.Node {
+	cursor := q.Paginate.CursorType
+	cursor.IsNull = false
+	return poet.Node(&pyast.Subscript{
+		Value: typeRefNode("models", pageClass),
+		Slice: poet.Tuple(q.Ret.Annotation(), cursor.Annotation()),
+	})
+}
+
+// addPageArgs adds the query arguments and the page size and cursor to the
+// arguments of a paging method.
+func (q Query) addPageArgs(args *pyast.Arguments) {
+	q.AddArgs(args)
+	args.KwOnlyArgs = append(args.KwOnlyArgs,
+		&pyast.Arg{Arg: pageSizeArg, Annotation: poet.Name("int")},
+		&pyast.Arg{Arg: cursorArg, Annotation: q.Paginate.CursorType.Annotation()},
+	)
+	for len(args.KwDefaults) < len(args.KwOnlyArgs)-1 {
+		args.KwDefaults = append(args.KwDefaults, &pyast.Node{})
+	}
+	if q.Paginate.Mode == paginateKeyset {
+		args.KwDefaults = append(args.KwDefaults, poet.Constant(nil))
+	} else {
+		args.KwDefaults = append(args.KwDefaults, constantInt(0))
+	}
+}
+
+func (q Query) pageArgDictNode() *pyast.Node {
+	dict := &pyast.Dict{}
+	if d := q.ArgDictNode(); d != nil {
+		dict.Keys = d.GetDict().Keys
+		dict.Values = d.GetDict().Values
+	}
+	dict.Keys = append(dict.Keys, poet.Constant("page_cursor"), poet.Constant("page_limit"))
+	dict.Values = append(dict.Values,
+		poet.Name(cursorArg),
+		poet.BinOp(poet.Name(pageSizeArg), &pyast.Add{}, constantInt(1)),
+	)
+	return &pyast.Node{
+		Node: &pyast.Node_Dict{
+			Dict: dict,
+		},
+	}
+}
+
+// pageSizeCheckNode returns the statement rejecting page sizes below one,
+// which would return an empty page that isn't the last one
+func pageSizeCheckNode() *pyast.Node {
+	return poet.Node(&pyast.If{
+		Test: poet.Node(&pyast.Compare{
+			Left:        poet.Name(pageSizeArg),
+			Ops:         []*pyast.Node{poet.Node(&pyast.Lt{})},
+			Comparators: []*pyast.Node{constantInt(1)},
+		}),
+		Body: []*pyast.Node{poet.Raise(poet.Node(&pyast.Call{
+			Func: poet.Name("ValueError"),
+			Args: []*pyast.Node{poet.Constant(pageSizeArg + " must be at least 1")},
+		}))},
+	})
+}
+
+// pageNodes returns the statements of a paging method fetching the rows of
+// result, an execute call or its awaited value.
+func pageNodes(q Query, result *pyast.Node) []*pyast.Node {
+	next := subscriptNode("items", poet.UnaryOp(&pyast.USub{}, constantInt(1)))
+	if q.Paginate.Mode == paginateKeyset {
+		if q.Paginate.KeyField != "" {
+			next = poet.Attribute(next, q.Paginate.KeyField)
+		}
+	} else {
+		next = poet.BinOp(poet.Name(cursorArg), &pyast.Add{}, poet.Node(&pyast.Call{
+			Func: poet.Name("len"),
+			Args: []*pyast.Node{poet.Name("items")},
+		}))
+	}
+	return []*pyast.Node{
+		assignNode("result", result),
+		assignNode("items", poet.Node(&pyast.ListComp{
+			Elt: q.Ret.RowNode("row"),
+			Generators: []*pyast.Comprehension{
+				{
+					Target: poet.Name("row"),
+					Iter: poet.Node(&pyast.Call{
+						Func: poet.Attribute(poet.Name("result"), "fetchmany"),
+						Args: []*pyast.Node{poet.Name(pageSizeArg)},
+					}),
+				},
+			},
+		})),
+		assignNode("next_cursor", poet.Constant(nil)),
+		poet.Node(&pyast.If{
+			Test: poet.Node(&pyast.Compare{
+				Left: poet.Node(&pyast.Call{
+					Func: poet.Attribute(poet.Name("result"), "fetchone"),
+				}),
+				Ops:         []*pyast.Node{poet.Node(&pyast.IsNot{})},
+				Comparators: []*pyast.Node{poet.Constant(nil)},
+			}),
+			Body: []*pyast.Node{assignNode("next_cursor", next)},
+		}),
+		// The result may not be exhausted after the extra row, so it is
+		// closed as first() does
+		poet.Expr(poet.Node(&pyast.Call{
+			Func: poet.Attribute(poet.Name("result"), "close"),
+		})),
+		poet.Return(poet.Node(&pyast.Call{
+			Func: typeRefNode("models", pageClass),
+			Keywords: []*pyast.Keyword{
+				{Arg: "items", Value: poet.Name("items")},
+				{Arg: "next_cursor", Value: poet.Name("next_cursor")},
+			},
+		})),
+	}
+}
+
+func pageFunctionDef(conf Config, q Query) *pyast.FunctionDef {
+	f := &pyast.FunctionDef{
+		Name: pageMethodName(q),
+		Args: &pyast.Arguments{
+			Args: []*pyast.Arg{{Arg: "self"}},
+		},
+		Returns: pageAnnotation(q),
+	}
+	q.addPageArgs(f.Args)
+	if doc := docstringNode(conf, q); doc != nil {
+		f.Body = append(f.Body, doc)
+	}
+	f.Body = append(f.Body, pageSizeCheckNode())
+	exec := connMethodNode(conf, "execute", q.textNode(pageConstantName(q)), q.pageArgDictNode())
+	f.Body = append(f.Body, wrapMethodBody(conf, q, f.Name, pageConstantName(q), pageNodes(q, exec), false)...)
+	return f
+}
+
+func asyncPageFunctionDef(conf Config, q Query) *pyast.AsyncFunctionDef {
+	f := &pyast.AsyncFunctionDef{
+		Name: pageMethodName(q),
+		Args: &pyast.Arguments{
+			Args: []*pyast.Arg{{Arg: "self"}},
+		},
+		Returns: pageAnnotation(q),
+	}
+	q.addPageArgs(f.Args)
+	if doc := docstringNode(conf, q); doc != nil {
+		f.Body = append(f.Body, doc)
+	}
+	f.Body = append(f.Body, pageSizeCheckNode())
+	exec := connMethodNode(conf, "execute", q.textNode(pageConstantName(q)), q.pageArgDictNode())
+	f.Body = append(f.Body, wrapMethodBody(conf, q, f.Name, pageConstantName(q), pageNodes(q, poet.Await(exec)), true)...)
+	return f
+}
+
+// pageClassDef returns the generic class returned by the paging methods
+func pageClassDef(conf Config) *pyast.ClassDef {
+	var def *pyast.ClassDef
+	if conf.EmitPydanticModels {
+		def = pydanticNode(pageClass)
+	} else {
+		def = dataclassNode(pageClass)
+	}
+	def.Bases = append(def.Bases, poet.Node(&pyast.Subscript{
+		Value: poet.Name("Generic"),
+		Slice: poet.Tuple(poet.Name(pageItemVar), poet.Name(pageCursorVar)),
+	}))
+	def.Body = append(def.Body,
+		poet.Node(&pyast.AnnAssign{
+			Target:     &pyast.Name{Id: "items"},
+			Annotation: subscriptNode("List", poet.Name(pageItemVar)),
+		}),
+		poet.Node(&pyast.AnnAssign{
+			Target:     &pyast.Name{Id: "next_cursor"},
+			Annotation: subscriptNode("Optional", poet.Name(pageCursorVar)),
+		}),
+	)
+	return def
+}
+
+// checkPageNames checks that the Page class and its type variables don't
+// conflict with a model or enum of the same name
+func checkPageNames(models []Struct, enums []Enum) error {
+	defined := map[string]bool{}
+	for _, m := range models {
+		defined[m.Name] = true
+	}
+	for _, e := range enums {
+		defined[e.Name] = true
+	}
+	for _, name := range pageNames {
+		if defined[name] {
+			return fmt.Errorf("@paginate: %s conflicts with a model or enum of the same name", name)
+		}
+	}
+	return nil
+}
+
+func queriesPaginate(queries []Query) bool {
+	for _, q := range queries {
+		if q.Paginate != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -12,6 +12,17 @@ func Alias(name string) *ast.Node {
 	}
 }
 
+func AliasAs(name, asname string) *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_Alias{
+			Alias: &ast.Alias{
+				Name:   name,
+				Asname: asname,
+			},
+		},
+	}
+}
+
 func Await(value *ast.Node) *ast.Node {
 	return &ast.Node{
 		Node: &ast.Node_Await{
@@ -33,6 +44,29 @@ func Attribute(value *ast.Node, attr string) *ast.Node {
 	}
 }
 
+func BinOp(left *ast.Node, op proto, right *ast.Node) *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_BinOp{
+			BinOp: &ast.BinOp{
+				Left:  left,
+				Op:    Node(op),
+				Right: right,
+			},
+		},
+	}
+}
+
+func BoolOp(op proto, values ...*ast.Node) *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_BoolOp{
+			BoolOp: &ast.BoolOp{
+				Op:     Node(op),
+				Values: values,
+			},
+		},
+	}
+}
+
 func Comment(text string) *ast.Node {
 	return &ast.Node{
 		Node: &ast.Node_Comment{
@@ -43,6 +77,18 @@ func Comment(text string) *ast.Node {
 	}
 }
 
+func Ellipsis() *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_Constant{
+			Constant: &ast.Constant{
+				Value: &ast.Constant_Ellipsis{
+					Ellipsis: true,
+				},
+			},
+		},
+	}
+}
+
 func Expr(value *ast.Node) *ast.Node {
 	return &ast.Node{
 		Node: &ast.Node_Expr{
@@ -61,6 +107,16 @@ func Is() *ast.Node {
 	}
 }
 
+func List(elts ...*ast.Node) *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_List{
+			List: &ast.List{
+				Elts: elts,
+			},
+		},
+	}
+}
+
 func Name(id string) *ast.Node {
 	return &ast.Node{
 		Node: &ast.Node_Name{
@@ -69,6 +125,16 @@ func Name(id string) *ast.Node {
 	}
 }
 
+func Raise(exc *ast.Node) *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_Raise{
+			Raise: &ast.Raise{
+				Exc: exc,
+			},
+		},
+	}
+}
+
 func Return(value *ast.Node) *ast.Node {
 	return &ast.Node{
 		Node: &ast.Node_Return{
@@ -79,6 +145,37 @@ func Return(value *ast.Node) *ast.Node {
 	}
 }
 
+func Starred(value *ast.Node) *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_Starred{
+			Starred: &ast.Starred{
+				Value: value,
+			},
+		},
+	}
+}
+
+func Tuple(elts ...*ast.Node) *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_Tuple{
+			Tuple: &ast.Tuple{
+				Elts: elts,
+			},
+		},
+	}
+}
+
+func UnaryOp(op proto, operand *ast.Node) *ast.Node {
+	return &ast.Node{
+		Node: &ast.Node_UnaryOp{
+			UnaryOp: &ast.UnaryOp{
+				Op:      Node(op),
+				Operand: operand,
+			},
+		},
+	}
+}
+
 func Yield(value *ast.Node) *ast.Node {
 	return &ast.Node{
 		Node: &ast.Node_Yield{
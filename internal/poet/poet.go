@@ -19,6 +19,13 @@ func Nodes(nodes ...proto) []*ast.Node {
 func Node(node proto) *ast.Node {
 	switch n := node.(type) {
 
+	case *ast.Add:
+		return &ast.Node{
+			Node: &ast.Node_Add{
+				Add: n,
+			},
+		}
+
 	case *ast.Alias:
 		return &ast.Node{
 			Node: &ast.Node_Alias{
@@ -26,6 +33,34 @@ func Node(node proto) *ast.Node {
 			},
 		}
 
+	case *ast.And:
+		return &ast.Node{
+			Node: &ast.Node_And{
+				And: n,
+			},
+		}
+
+	case *ast.Arg:
+		return &ast.Node{
+			Node: &ast.Node_Arg{
+				Arg: n,
+			},
+		}
+
+	case *ast.Arguments:
+		return &ast.Node{
+			Node: &ast.Node_Arguments{
+				Arguments: n,
+			},
+		}
+
+	case *ast.AsyncWith:
+		return &ast.Node{
+			Node: &ast.Node_AsyncWith{
+				AsyncWith: n,
+			},
+		}
+
 	case *ast.Await:
 		return &ast.Node{
 			Node: &ast.Node_Await{
@@ -68,6 +103,48 @@ func Node(node proto) *ast.Node {
 			},
 		}
 
+	case *ast.BinOp:
+		return &ast.Node{
+			Node: &ast.Node_BinOp{
+				BinOp: n,
+			},
+		}
+
+	case *ast.BitAnd:
+		return &ast.Node{
+			Node: &ast.Node_BitAnd{
+				BitAnd: n,
+			},
+		}
+
+	case *ast.BitOr:
+		return &ast.Node{
+			Node: &ast.Node_BitOr{
+				BitOr: n,
+			},
+		}
+
+	case *ast.BitXor:
+		return &ast.Node{
+			Node: &ast.Node_BitXor{
+				BitXor: n,
+			},
+		}
+
+	case *ast.BoolOp:
+		return &ast.Node{
+			Node: &ast.Node_BoolOp{
+				BoolOp: n,
+			},
+		}
+
+	case *ast.Break:
+		return &ast.Node{
+			Node: &ast.Node_Break{
+				Break: n,
+			},
+		}
+
 	case *ast.Call:
 		return &ast.Node{
 			Node: &ast.Node_Call{
@@ -100,6 +177,27 @@ func Node(node proto) *ast.Node {
 
 	// case *ast.Dict:
 
+	case *ast.Continue:
+		return &ast.Node{
+			Node: &ast.Node_Continue{
+				Continue: n,
+			},
+		}
+
+	case *ast.Div:
+		return &ast.Node{
+			Node: &ast.Node_Div{
+				Div: n,
+			},
+		}
+
+	case *ast.Eq:
+		return &ast.Node{
+			Node: &ast.Node_Eq{
+				Eq: n,
+			},
+		}
+
 	case *ast.Expr:
 		return &ast.Node{
 			Node: &ast.Node_Expr{
@@ -107,6 +205,13 @@ func Node(node proto) *ast.Node {
 			},
 		}
 
+	case *ast.FloorDiv:
+		return &ast.Node{
+			Node: &ast.Node_FloorDiv{
+				FloorDiv: n,
+			},
+		}
+
 	case *ast.For:
 		return &ast.Node{
 			Node: &ast.Node_For{
@@ -121,6 +226,27 @@ func Node(node proto) *ast.Node {
 			},
 		}
 
+	case *ast.Global:
+		return &ast.Node{
+			Node: &ast.Node_Global{
+				Global: n,
+			},
+		}
+
+	case *ast.Gt:
+		return &ast.Node{
+			Node: &ast.Node_Gt{
+				Gt: n,
+			},
+		}
+
+	case *ast.GtE:
+		return &ast.Node{
+			Node: &ast.Node_GtE{
+				GtE: n,
+			},
+		}
+
 	case *ast.If:
 		return &ast.Node{
 			Node: &ast.Node_If{
@@ -140,6 +266,125 @@ func Node(node proto) *ast.Node {
 	// case *ast.Node_Keyword:
 	// 	w.printKeyword(n.Keyword, indent)
 
+	case *ast.IfExp:
+		return &ast.Node{
+			Node: &ast.Node_IfExp{
+				IfExp: n,
+			},
+		}
+
+	case *ast.Import:
+		return &ast.Node{
+			Node: &ast.Node_Import{
+				Import: n,
+			},
+		}
+
+	case *ast.ImportFrom:
+		return &ast.Node{
+			Node: &ast.Node_ImportFrom{
+				ImportFrom: n,
+			},
+		}
+
+	case *ast.ImportGroup:
+		return &ast.Node{
+			Node: &ast.Node_ImportGroup{
+				ImportGroup: n,
+			},
+		}
+
+	case *ast.In:
+		return &ast.Node{
+			Node: &ast.Node_In{
+				In: n,
+			},
+		}
+
+	case *ast.Invert:
+		return &ast.Node{
+			Node: &ast.Node_Invert{
+				Invert: n,
+			},
+		}
+
+	case *ast.Is:
+		return &ast.Node{
+			Node: &ast.Node_Is{
+				Is: n,
+			},
+		}
+
+	case *ast.IsNot:
+		return &ast.Node{
+			Node: &ast.Node_IsNot{
+				IsNot: n,
+			},
+		}
+
+	case *ast.Keyword:
+		return &ast.Node{
+			Node: &ast.Node_Keyword{
+				Keyword: n,
+			},
+		}
+
+	case *ast.Lambda:
+		return &ast.Node{
+			Node: &ast.Node_Lambda{
+				Lambda: n,
+			},
+		}
+
+	case *ast.List:
+		return &ast.Node{
+			Node: &ast.Node_List{
+				List: n,
+			},
+		}
+
+	case *ast.ListComp:
+		return &ast.Node{
+			Node: &ast.Node_ListComp{
+				ListComp: n,
+			},
+		}
+
+	case *ast.LShift:
+		return &ast.Node{
+			Node: &ast.Node_LShift{
+				LShift: n,
+			},
+		}
+
+	case *ast.Lt:
+		return &ast.Node{
+			Node: &ast.Node_Lt{
+				Lt: n,
+			},
+		}
+
+	case *ast.LtE:
+		return &ast.Node{
+			Node: &ast.Node_LtE{
+				LtE: n,
+			},
+		}
+
+	case *ast.MatMult:
+		return &ast.Node{
+			Node: &ast.Node_MatMult{
+				MatMult: n,
+			},
+		}
+
+	case *ast.Mod:
+		return &ast.Node{
+			Node: &ast.Node_Mod{
+				Mod: n,
+			},
+		}
+
 	case *ast.Module:
 		return &ast.Node{
 			Node: &ast.Node_Module{
@@ -161,6 +406,153 @@ func Node(node proto) *ast.Node {
 	// case *ast.Node_Subscript:
 	// 	w.printSubscript(n.Subscript, indent)
 
+	case *ast.Mult:
+		return &ast.Node{
+			Node: &ast.Node_Mult{
+				Mult: n,
+			},
+		}
+
+	case *ast.Name:
+		return &ast.Node{
+			Node: &ast.Node_Name{
+				Name: n,
+			},
+		}
+
+	case *ast.Not:
+		return &ast.Node{
+			Node: &ast.Node_Not{
+				Not: n,
+			},
+		}
+
+	case *ast.NotEq:
+		return &ast.Node{
+			Node: &ast.Node_NotEq{
+				NotEq: n,
+			},
+		}
+
+	case *ast.NotIn:
+		return &ast.Node{
+			Node: &ast.Node_NotIn{
+				NotIn: n,
+			},
+		}
+
+	case *ast.Or:
+		return &ast.Node{
+			Node: &ast.Node_Or{
+				Or: n,
+			},
+		}
+
+	case *ast.Pass:
+		return &ast.Node{
+			Node: &ast.Node_Pass{
+				Pass: n,
+			},
+		}
+
+	case *ast.Pow:
+		return &ast.Node{
+			Node: &ast.Node_Pow{
+				Pow: n,
+			},
+		}
+
+	case *ast.Raise:
+		return &ast.Node{
+			Node: &ast.Node_Raise{
+				Raise: n,
+			},
+		}
+
+	case *ast.RShift:
+		return &ast.Node{
+			Node: &ast.Node_RShift{
+				RShift: n,
+			},
+		}
+
+	case *ast.Return:
+		return &ast.Node{
+			Node: &ast.Node_Return{
+				Return: n,
+			},
+		}
+
+	case *ast.Starred:
+		return &ast.Node{
+			Node: &ast.Node_Starred{
+				Starred: n,
+			},
+		}
+
+	case *ast.Sub:
+		return &ast.Node{
+			Node: &ast.Node_Sub{
+				Sub: n,
+			},
+		}
+
+	case *ast.Subscript:
+		return &ast.Node{
+			Node: &ast.Node_Subscript{
+				Subscript: n,
+			},
+		}
+
+	case *ast.Try:
+		return &ast.Node{
+			Node: &ast.Node_Try{
+				Try: n,
+			},
+		}
+
+	case *ast.Tuple:
+		return &ast.Node{
+			Node: &ast.Node_Tuple{
+				Tuple: n,
+			},
+		}
+
+	case *ast.TypeAlias:
+		return &ast.Node{
+			Node: &ast.Node_TypeAlias{
+				TypeAlias: n,
+			},
+		}
+
+	case *ast.UAdd:
+		return &ast.Node{
+			Node: &ast.Node_UAdd{
+				UAdd: n,
+			},
+		}
+
+	case *ast.UnaryOp:
+		return &ast.Node{
+			Node: &ast.Node_UnaryOp{
+				UnaryOp: n,
+			},
+		}
+
+	case *ast.USub:
+		return &ast.Node{
+			Node: &ast.Node_USub{
+				USub: n,
+			},
+		}
+
+	case *ast.With:
+		return &ast.Node{
+			Node: &ast.Node_With{
+				With: n,
+			},
+		}
+
 	case *ast.Yield:
 		return &ast.Node{
 			Node: &ast.Node_Yield{
@@ -198,6 +590,17 @@ func Constant(value interface{}) *ast.Node {
 			},
 		}
 
+	case bool:
+		return &ast.Node{
+			Node: &ast.Node_Constant{
+				Constant: &ast.Constant{
+					Value: &ast.Constant_Bool{
+						Bool: n,
+					},
+				},
+			},
+		}
+
 	case nil:
 		return &ast.Node{
 			Node: &ast.Node_Constant{
@@ -0,0 +1,134 @@
+package printer
+
+import "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+
+// Operator precedence, from lowest to highest, as used by the ast module to
+// decide where parentheses are needed.
+const (
+	precTuple = iota + 1
+	precYield
+	precTest
+	precOr
+	precAnd
+	precNot
+	precCmp
+	precExpr
+	precBXor
+	precBAnd
+	precShift
+	precArith
+	precTerm
+	precFactor
+	precPower
+	precAwait
+	precAtom
+)
+
+const precBOr = precExpr
+
+type operator struct {
+	text string
+	prec int
+}
+
+// op returns the text and precedence of an operator node
+func op(node *ast.Node) (operator, bool) {
+	switch node.Node.(type) {
+	case *ast.Node_Add:
+		return operator{"+", precArith}, true
+	case *ast.Node_Sub:
+		return operator{"-", precArith}, true
+	case *ast.Node_Mult:
+		return operator{"*", precTerm}, true
+	case *ast.Node_MatMult:
+		return operator{"@", precTerm}, true
+	case *ast.Node_Div:
+		return operator{"/", precTerm}, true
+	case *ast.Node_Mod:
+		return operator{"%", precTerm}, true
+	case *ast.Node_FloorDiv:
+		return operator{"//", precTerm}, true
+	case *ast.Node_Pow:
+		return operator{"**", precPower}, true
+	case *ast.Node_LShift:
+		return operator{"<<", precShift}, true
+	case *ast.Node_RShift:
+		return operator{">>", precShift}, true
+	case *ast.Node_BitOr:
+		return operator{"|", precBOr}, true
+	case *ast.Node_BitXor:
+		return operator{"^", precBXor}, true
+	case *ast.Node_BitAnd:
+		return operator{"&", precBAnd}, true
+	case *ast.Node_And:
+		return operator{"and", precAnd}, true
+	case *ast.Node_Or:
+		return operator{"or", precOr}, true
+	case *ast.Node_Not:
+		return operator{"not", precNot}, true
+	case *ast.Node_Invert:
+		return operator{"~", precFactor}, true
+	case *ast.Node_UAdd:
+		return operator{"+", precFactor}, true
+	case *ast.Node_USub:
+		return operator{"-", precFactor}, true
+	case *ast.Node_Eq:
+		return operator{"==", precCmp}, true
+	case *ast.Node_NotEq:
+		return operator{"!=", precCmp}, true
+	case *ast.Node_Lt:
+		return operator{"<", precCmp}, true
+	case *ast.Node_LtE:
+		return operator{"<=", precCmp}, true
+	case *ast.Node_Gt:
+		return operator{">", precCmp}, true
+	case *ast.Node_GtE:
+		return operator{">=", precCmp}, true
+	case *ast.Node_Is:
+		return operator{"is", precCmp}, true
+	case *ast.Node_IsNot:
+		return operator{"is not", precCmp}, true
+	case *ast.Node_In:
+		return operator{"in", precCmp}, true
+	case *ast.Node_NotIn:
+		return operator{"not in", precCmp}, true
+	}
+	return operator{}, false
+}
+
+// precedence returns the precedence of an expression. Expressions that are
+// never put in parentheses, like names and calls, are atoms.
+func precedence(node *ast.Node) int {
+	switch n := node.Node.(type) {
+	case *ast.Node_Await:
+		return precAwait
+	case *ast.Node_BinOp:
+		o, _ := op(n.BinOp.Op)
+		return o.prec
+	case *ast.Node_BoolOp:
+		o, _ := op(n.BoolOp.Op)
+		return o.prec
+	case *ast.Node_Compare:
+		return precCmp
+	case *ast.Node_IfExp, *ast.Node_Lambda:
+		return precTest
+	case *ast.Node_UnaryOp:
+		o, _ := op(n.UnaryOp.Op)
+		return o.prec
+	case *ast.Node_Yield:
+		return precYield
+	}
+	return precAtom
+}
+
+// printExpr prints an expression in a context that binds with the given
+// precedence, adding parentheses if the expression binds less tightly.
+func (w *writer) printExpr(node *ast.Node, indent int32, prec int) {
+	if precedence(node) < prec {
+		w.open("(", bracketGroup)
+		w.printNode(node, indent)
+		w.close(")")
+		return
+	}
+	w.printNode(node, indent)
+}
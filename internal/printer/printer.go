@@ -1,6 +1,7 @@
 package printer
 
 import (
+	"bytes"
 	"strconv"
 	"strings"
 
@@ -10,9 +11,24 @@ import (
 type writer struct {
 	options Options
 	src     []byte
+	// toks holds the printed source as tokens, which are laid out again
+	// if the lines are wrapped
+	toks []token
+	// prefix is the whitespace printed since the last token
+	prefix string
 }
 
 type Options struct {
+	// LineLength is the maximum length of a line. Longer lines are split
+	// at brackets like black does. Lines are not split if it is zero.
+	LineLength int
+	// SkipMagicTrailingComma joins exploded brackets that fit on one
+	// line, instead of keeping them exploded because of their trailing
+	// comma. It only applies if LineLength is set.
+	SkipMagicTrailingComma bool
+	// RawStrings writes strings containing backslashes as raw strings
+	// when possible, instead of escaping the backslashes.
+	RawStrings bool
 }
 
 type PrintResult struct {
@@ -22,18 +38,94 @@ type PrintResult struct {
 func Print(node *ast.Node, options Options) PrintResult {
 	w := writer{options: options}
 	w.printNode(node, 0)
+	if options.LineLength > 0 {
+		w.src = wrap(w.toks, w.prefix, options)
+	}
 	return PrintResult{
 		Python: w.src,
 	}
 }
 
+// print prints names, keywords, operators and whitespace. Brackets, commas,
+// strings and comments are printed with their own methods, so that the
+// tokens of the source are known when lines are wrapped.
 func (w *writer) print(text string) {
 	w.src = append(w.src, text...)
+	for text != "" {
+		i := strings.IndexFunc(text, func(r rune) bool { return r != ' ' && r != '\n' })
+		if i < 0 {
+			w.prefix += text
+			return
+		}
+		w.prefix += text[:i]
+		text = text[i:]
+		j := strings.IndexAny(text, " \n")
+		if j < 0 {
+			j = len(text)
+		}
+		w.addToken(token{kind: tokenAtom, text: text[:j]})
+		text = text[j:]
+	}
+}
+
+// addToken adds a token after the whitespace printed since the last one.
+// Atoms printed without whitespace between them, like the parts of an
+// attribute, are a single token.
+func (w *writer) addToken(t token) {
+	if n := len(w.toks); t.kind == tokenAtom && w.prefix == "" && n > 0 && w.toks[n-1].kind == tokenAtom {
+		w.toks[n-1].text += t.text
+		return
+	}
+	t.prefix = w.prefix
+	w.prefix = ""
+	w.toks = append(w.toks, t)
+}
+
+func (w *writer) printToken(t token) {
+	w.src = append(w.src, t.text...)
+	w.addToken(t)
+}
+
+func (w *writer) open(text string, bracket bracketKind) {
+	w.printToken(token{kind: tokenOpen, text: text, bracket: bracket})
+}
+
+func (w *writer) close(text string) {
+	w.printToken(token{kind: tokenClose, text: text})
+}
+
+func (w *writer) comma() {
+	w.printToken(token{kind: tokenComma, text: ","})
+}
+
+func (w *writer) printString(text string) {
+	w.printToken(token{kind: tokenString, text: text})
+}
+
+func (w *writer) printCommentText(text string) {
+	w.printToken(token{kind: tokenComment, text: "# " + text})
+}
+
+// A mark is a position in the printed source, which the printer can go back
+// to in order to print a node differently.
+type mark struct {
+	src, toks int
+	prefix    string
+}
+
+func (w *writer) mark() mark {
+	return mark{src: len(w.src), toks: len(w.toks), prefix: w.prefix}
+}
+
+func (w *writer) reset(m mark) {
+	w.src = w.src[:m.src]
+	w.toks = w.toks[:m.toks]
+	w.prefix = m.prefix
 }
 
 func (w *writer) printIndent(indent int32) {
 	for i, n := 0, int(indent); i < n; i++ {
-		w.src = append(w.src, "    "...)
+		w.print("    ")
 	}
 }
 
@@ -41,7 +133,7 @@ func (w *writer) printNode(node *ast.Node, indent int32) {
 	switch n := node.Node.(type) {
 
 	case *ast.Node_Alias:
-		w.print(n.Alias.Name)
+		w.printAlias(n.Alias, indent)
 
 	case *ast.Node_AnnAssign:
 		w.printAnnAssign(n.AnnAssign, indent)
@@ -58,9 +150,22 @@ func (w *writer) printNode(node *ast.Node, indent int32) {
 	case *ast.Node_Attribute:
 		w.printAttribute(n.Attribute, indent)
 
+	case *ast.Node_AsyncWith:
+		w.print("async ")
+		w.printWith(&ast.With{Items: n.AsyncWith.Items, Body: n.AsyncWith.Body}, indent)
+
 	case *ast.Node_Await:
 		w.printAwait(n.Await, indent)
 
+	case *ast.Node_BinOp:
+		w.printBinOp(n.BinOp, indent)
+
+	case *ast.Node_BoolOp:
+		w.printBoolOp(n.BoolOp, indent)
+
+	case *ast.Node_Break:
+		w.print("break")
+
 	case *ast.Node_Call:
 		w.printCall(n.Call, indent)
 
@@ -76,6 +181,9 @@ func (w *writer) printNode(node *ast.Node, indent int32) {
 	case *ast.Node_Constant:
 		w.printConstant(n.Constant, indent)
 
+	case *ast.Node_Continue:
+		w.print("continue")
+
 	case *ast.Node_Dict:
 		w.printDict(n.Dict, indent)
 
@@ -88,9 +196,22 @@ func (w *writer) printNode(node *ast.Node, indent int32) {
 	case *ast.Node_FunctionDef:
 		w.printFunctionDef(n.FunctionDef, indent)
 
+	case *ast.Node_Global:
+		w.print("global ")
+		for i, name := range n.Global.Names {
+			if i != 0 {
+				w.comma()
+				w.print(" ")
+			}
+			w.print(name)
+		}
+
 	case *ast.Node_If:
 		w.printIf(n.If, indent)
 
+	case *ast.Node_IfExp:
+		w.printIfExp(n.IfExp, indent)
+
 	case *ast.Node_Import:
 		w.printImport(n.Import, indent)
 
@@ -106,6 +227,15 @@ func (w *writer) printNode(node *ast.Node, indent int32) {
 	case *ast.Node_Keyword:
 		w.printKeyword(n.Keyword, indent)
 
+	case *ast.Node_Lambda:
+		w.printLambda(n.Lambda, indent)
+
+	case *ast.Node_List:
+		w.printList(n.List, indent)
+
+	case *ast.Node_ListComp:
+		w.printListComp(n.ListComp, indent)
+
 	case *ast.Node_Module:
 		w.printModule(n.Module, indent)
 
@@ -115,25 +245,61 @@ func (w *writer) printNode(node *ast.Node, indent int32) {
 	case *ast.Node_Pass:
 		w.print("pass")
 
+	case *ast.Node_Raise:
+		w.printRaise(n.Raise, indent)
+
 	case *ast.Node_Return:
 		w.printReturn(n.Return, indent)
 
+	case *ast.Node_Starred:
+		w.print("*")
+		w.printExpr(n.Starred.Value, indent, precExpr)
+
 	case *ast.Node_Subscript:
 		w.printSubscript(n.Subscript, indent)
 
+	case *ast.Node_Try:
+		w.printTry(n.Try, indent)
+
+	case *ast.Node_Tuple:
+		w.printTuple(n.Tuple, indent)
+
+	case *ast.Node_TypeAlias:
+		w.print("type ")
+		w.printName(n.TypeAlias.Name, indent)
+		w.print(" = ")
+		w.printExpr(n.TypeAlias.Value, indent, precTest)
+
+	case *ast.Node_UnaryOp:
+		w.printUnaryOp(n.UnaryOp, indent)
+
+	case *ast.Node_With:
+		w.printWith(n.With, indent)
+
 	case *ast.Node_Yield:
 		w.printYield(n.Yield, indent)
 
 	default:
+		if o, ok := op(node); ok {
+			w.print(o.text)
+			return
+		}
 		panic(n)
 
 	}
 }
 
+func (w *writer) printAlias(a *ast.Alias, indent int32) {
+	w.print(a.Name)
+	if a.Asname != "" {
+		w.print(" as ")
+		w.print(a.Asname)
+	}
+}
+
 func (w *writer) printAnnAssign(aa *ast.AnnAssign, indent int32) {
 	if aa.Comment != "" {
-		w.print("# ")
-		w.print(aa.Comment)
+		w.printCommentText(aa.Comment)
 		w.print("\n")
 		w.printIndent(indent)
 	}
@@ -150,15 +316,72 @@ func (w *writer) printArg(a *ast.Arg, indent int32) {
 	}
 }
 
-func (w *writer) printAssign(a *ast.Assign, indent int32) {
-	for i, name := range a.Targets {
-		w.printNode(name, indent)
-		if i != len(a.Targets)-1 {
-			w.print(", ")
+// printArgDefault prints an argument and its default value, if any. Spaces
+// are put around the equal sign only if the argument is annotated.
+func (w *writer) printArgDefault(a *ast.Arg, value *ast.Node, indent int32) {
+	w.printArg(a, indent)
+	if value == nil || value.Node == nil {
+		return
+	}
+	if a.Annotation != nil {
+		w.print(" = ")
+	} else {
+		w.print("=")
+	}
+	w.printExpr(value, indent, precTest)
+}
+
+func (w *writer) printArguments(a *ast.Arguments, indent int32) {
+	first := true
+	sep := func() {
+		if !first {
+			w.comma()
+			w.print(" ")
+		}
+		first = false
+	}
+	positional := append(append([]*ast.Arg{}, a.PosOnlyArgs...), a.Args...)
+	offset := len(positional) - len(a.Defaults)
+	for i, arg := range positional {
+		sep()
+		var value *ast.Node
+		if i >= offset {
+			value = a.Defaults[i-offset]
+		}
+		w.printArgDefault(arg, value, indent)
+		if i == len(a.PosOnlyArgs)-1 {
+			sep()
+			w.print("/")
 		}
 	}
-	w.print(" = ")
-	w.printNode(a.Value, indent)
+	if a.Vararg != nil || len(a.KwOnlyArgs) > 0 {
+		sep()
+		w.print("*")
+		if a.Vararg != nil {
+			w.printArg(a.Vararg, indent)
+		}
+	}
+	for i, arg := range a.KwOnlyArgs {
+		sep()
+		var value *ast.Node
+		if i < len(a.KwDefaults) {
+			value = a.KwDefaults[i]
+		}
+		w.printArgDefault(arg, value, indent)
+	}
+	if a.Kwarg != nil {
+		sep()
+		w.print("**")
+		w.printArg(a.Kwarg, indent)
+	}
+}
+
+func (w *writer) printAssign(a *ast.Assign, indent int32) {
+	for _, target := range a.Targets {
+		w.printTarget(target, indent)
+		w.print(" = ")
+	}
+	w.printExpr(a.Value, indent, precTest)
 }
 
 func (w *writer) printAsyncFor(n *ast.AsyncFor, indent int32) {
@@ -171,71 +394,112 @@ func (w *writer) printAsyncFor(n *ast.AsyncFor, indent int32) {
 }
 
 func (w *writer) printAsyncFunctionDef(afd *ast.AsyncFunctionDef, indent int32) {
-	w.print("async ")
-	w.printFunctionDef(&ast.FunctionDef{
-		Name:    afd.Name,
-		Args:    afd.Args,
-		Body:    afd.Body,
-		Returns: afd.Returns,
+	w.printDef("async def ", &ast.FunctionDef{
+		Name:          afd.Name,
+		Args:          afd.Args,
+		Body:          afd.Body,
+		Returns:       afd.Returns,
+		DecoratorList: afd.DecoratorList,
 	}, indent)
 }
 
 func (w *writer) printAttribute(a *ast.Attribute, indent int32) {
-	if _, ok := a.Value.Node.(*ast.Node_Await); ok {
-		w.print("(")
-		w.printNode(a.Value, indent)
-		w.print(")")
-	} else {
-		w.printNode(a.Value, indent)
-	}
+	w.printExpr(a.Value, indent, precAtom)
 	w.print(".")
 	w.print(a.Attr)
 }
 
 func (w *writer) printAwait(n *ast.Await, indent int32) {
 	w.print("await ")
-	w.printNode(n.Value, indent)
+	w.printExpr(n.Value, indent, precAtom)
+}
+
+func (w *writer) printBinOp(b *ast.BinOp, indent int32) {
+	o, _ := op(b.Op)
+	// Binary operators are left-associative, except for the power operator
+	left, right := o.prec, o.prec+1
+	if o.prec == precPower {
+		left, right = right, left
+	}
+	w.printExpr(b.Left, indent, left)
+	w.print(" ")
+	w.print(o.text)
+	w.print(" ")
+	w.printExpr(b.Right, indent, right)
+}
+
+func (w *writer) printBody(body []*ast.Node, indent int32) {
+	for i, node := range body {
+		w.printIndent(indent + 1)
+		w.printNode(node, indent+1)
+		if i != len(body)-1 {
+			w.print("\n")
+		}
+	}
+}
+
+func (w *writer) printBoolOp(b *ast.BoolOp, indent int32) {
+	o, _ := op(b.Op)
+	// Each value after the first binds more tightly, so that nested
+	// boolean operations keep their grouping
+	prec := o.prec + 1
+	for i, node := range b.Values {
+		if i != 0 {
+			w.print(" ")
+			w.print(o.text)
+			w.print(" ")
+		}
+		w.printExpr(node, indent, prec)
+		prec++
+	}
 }
 
 func (w *writer) printCall(c *ast.Call, indent int32) {
-	w.printNode(c.Func, indent)
-	w.print("(")
+	w.printExpr(c.Func, indent, precAtom)
+	w.open("(", bracketCall)
+	// Calls with keywords are split, one argument per line
+	split := len(c.Keywords) > 0
 	for i, a := range c.Args {
-		w.printNode(a, indent)
-		if i != len(c.Args)-1 {
-			w.print(", ")
+		if split {
+			w.print("\n")
+			w.printIndent(indent + 1)
+			w.printExpr(a, indent+1, precTest)
+			w.comma()
+		} else {
+			w.printExpr(a, indent, precTest)
+			if i != len(c.Args)-1 {
+				w.comma()
+				w.print(" ")
+			}
 		}
 	}
 	for _, kw := range c.Keywords {
 		w.print("\n")
 		w.printIndent(indent + 1)
 		w.printKeyword(kw, indent+1)
-		w.print(",")
+		w.comma()
 	}
 	if len(c.Keywords) > 0 {
 		w.print("\n")
 		w.printIndent(indent)
 	}
-	w.print(")")
+	w.close(")")
 }
 
 func (w *writer) printClassDef(cd *ast.ClassDef, indent int32) {
-	for _, node := range cd.DecoratorList {
-		w.print("@")
-		w.printNode(node, indent)
-		w.print("\n")
-	}
+	w.printDecorators(cd.DecoratorList, indent)
 	w.print("class ")
 	w.print(cd.Name)
 	if len(cd.Bases) > 0 {
-		w.print("(")
+		w.open("(", bracketCall)
 		for i, node := range cd.Bases {
 			w.printNode(node, indent)
 			if i != len(cd.Bases)-1 {
-				w.print(", ")
+				w.comma()
+				w.print(" ")
 			}
 		}
-		w.print(")")
+		w.close(")")
 	}
 	w.print(":\n")
 	for i, node := range cd.Body {
@@ -251,16 +515,13 @@ func (w *writer) printClassDef(cd *ast.ClassDef, indent int32) {
 		// A docstring is a string literal that occurs as the first
 		// statement in a module, function, class, or method
 		// definition. Such a docstring becomes the __doc__ special
-		// attribute of that object.
-		if i == 0 {
-			if e, ok := node.Node.(*ast.Node_Expr); ok {
-				if c, ok := e.Expr.Value.Node.(*ast.Node_Constant); ok {
-					w.print(`""`)
-					w.printConstant(c.Constant, indent)
-					w.print(`""`)
-					w.print("\n")
-					continue
-				}
+		// attribute of that object. String literals following a field
+		// are attribute docstrings, which are formatted the same way.
+		if e, ok := node.Node.(*ast.Node_Expr); ok {
+			if c, ok := e.Expr.Value.Node.(*ast.Node_Constant); ok && isStr(c.Constant) {
+				w.printDocstring(c.Constant.GetStr(), indent+1)
+				w.print("\n")
+				continue
 			}
 		}
 		w.printNode(node, indent+1)
@@ -268,8 +529,70 @@ func (w *writer) printClassDef(cd *ast.ClassDef, indent int32) {
 	}
 }
 
+// printDocstring prints a docstring at the given indentation. The closing
+// quotes of a multi-line docstring are put on a line by themselves.
+func (w *writer) printDocstring(text string, indent int32) {
+	var b strings.Builder
+	if w.options.RawStrings && canTripleQuoteRaw(text) {
+		b.WriteString(`r"""`)
+	} else {
+		b.WriteString(`"""`)
+		text = escapeTripleQuoted(text)
+	}
+	prefix := strings.Repeat("    ", int(indent))
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i != 0 {
+			b.WriteString("\n")
+			if line != "" {
+				b.WriteString(prefix)
+			}
+		}
+		b.WriteString(line)
+	}
+	if len(lines) > 1 {
+		b.WriteString("\n")
+		b.WriteString(prefix)
+	}
+	b.WriteString(`"""`)
+	w.printString(b.String())
+}
+
+func isStr(c *ast.Constant) bool {
+	_, ok := c.Value.(*ast.Constant_Str)
+	return ok
+}
+
+// isEllipsis reports whether a body consists of a single "...", as used in
+// stubs, which is printed on the same line as the definition.
+func isEllipsis(body []*ast.Node) bool {
+	if len(body) != 1 {
+		return false
+	}
+	e, ok := body[0].Node.(*ast.Node_Expr)
+	if !ok {
+		return false
+	}
+	c, ok := e.Expr.Value.Node.(*ast.Node_Constant)
+	if !ok {
+		return false
+	}
+	_, ok = c.Constant.Value.(*ast.Constant_Ellipsis)
+	return ok
+}
+
 func (w *writer) printConstant(c *ast.Constant, indent int32) {
 	switch n := c.Value.(type) {
+	case *ast.Constant_Bool:
+		if n.Bool {
+			w.print("True")
+		} else {
+			w.print("False")
+		}
+
+	case *ast.Constant_Ellipsis:
+		w.print("...")
+
 	case *ast.Constant_Int:
 		w.print(strconv.Itoa(int(n.Int)))
 
@@ -277,13 +600,7 @@ func (w *writer) printConstant(c *ast.Constant, indent int32) {
 		w.print("None")
 
 	case *ast.Constant_Str:
-		str := `"`
-		if strings.Contains(n.Str, "\n") {
-			str = `"""`
-		}
-		w.print(str)
-		w.print(n.Str)
-		w.print(str)
+		w.printString(pyString(n.Str, w.options.RawStrings))
 
 	default:
 		panic(n)
@@ -291,20 +608,26 @@ func (w *writer) printConstant(c *ast.Constant, indent int32) {
 }
 
 func (w *writer) printComment(c *ast.Comment, indent int32) {
-	w.print("# ")
-	w.print(c.Text)
+	w.printCommentText(c.Text)
 	w.print("\n")
 }
 
 func (w *writer) printCompare(c *ast.Compare, indent int32) {
-	w.printNode(c.Left, indent)
-	w.print(" ")
-	for _, node := range c.Ops {
+	w.printExpr(c.Left, indent, precCmp+1)
+	for i, node := range c.Ops {
+		w.print(" ")
 		w.printNode(node, indent)
 		w.print(" ")
+		w.printExpr(c.Comparators[i], indent, precCmp+1)
 	}
-	for _, node := range c.Comparators {
+}
+
+func (w *writer) printDecorators(decorators []*ast.Node, indent int32) {
+	for _, node := range decorators {
+		w.print("@")
 		w.printNode(node, indent)
+		w.print("\n")
+		w.printIndent(indent)
 	}
 }
 
@@ -312,7 +635,7 @@ func (w *writer) printDict(d *ast.Dict, indent int32) {
 	if len(d.Keys) != len(d.Values) {
 		panic(`dict keys and values are not the same length`)
 	}
-	w.print("{")
+	w.open("{", bracketGroup)
 	split := len(d.Keys) > 3
 	keyIndent := indent
 	if split {
@@ -327,10 +650,9 @@ func (w *writer) printDict(d *ast.Dict, indent int32) {
 		w.print(": ")
 		w.printNode(d.Values[i], keyIndent)
 		if i != len(d.Keys)-1 || split {
-			if split {
-				w.print(",")
-			} else {
-				w.print(", ")
+			w.comma()
+			if !split {
+				w.print(" ")
 			}
 		}
 	}
@@ -338,66 +660,83 @@ func (w *writer) printDict(d *ast.Dict, indent int32) {
 		w.print("\n")
 		w.printIndent(indent)
 	}
-	w.print("}")
+	w.close("}")
 }
 
 func (w *writer) printFor(n *ast.For, indent int32) {
 	w.print("for ")
-	w.printNode(n.Target, indent)
+	w.printTarget(n.Target, indent)
 	w.print(" in ")
-	w.printNode(n.Iter, indent)
+	w.printExpr(n.Iter, indent, precTest)
 	w.print(":\n")
-	for i, node := range n.Body {
-		w.printIndent(indent + 1)
-		w.printNode(node, indent+1)
-		if i != len(n.Body)-1 {
-			w.print("\n")
-		}
-	}
+	w.printBody(n.Body, indent)
 }
 
 func (w *writer) printIf(i *ast.If, indent int32) {
 	w.print("if ")
-	w.printNode(i.Test, indent)
+	w.printExpr(i.Test, indent, precTest)
 	w.print(":\n")
-	for j, node := range i.Body {
-		w.printIndent(indent + 1)
-		w.printNode(node, indent+1)
-		if j != len(i.Body)-1 {
-			w.print("\n")
+	w.printBody(i.Body, indent)
+	if len(i.OrElse) == 0 {
+		return
+	}
+	w.print("\n")
+	w.printIndent(indent)
+	// An else branch containing a single if statement is an elif
+	if len(i.OrElse) == 1 {
+		if elif, ok := i.OrElse[0].Node.(*ast.Node_If); ok {
+			w.print("el")
+			w.printIf(elif.If, indent)
+			return
 		}
 	}
+	w.print("else:\n")
+	w.printBody(i.OrElse, indent)
+}
+
+func (w *writer) printIfExp(i *ast.IfExp, indent int32) {
+	w.printExpr(i.Body, indent, precTest+1)
+	w.print(" if ")
+	w.printExpr(i.Test, indent, precTest+1)
+	w.print(" else ")
+	w.printExpr(i.OrElse, indent, precTest)
 }
 
 func (w *writer) printFunctionDef(fd *ast.FunctionDef, indent int32) {
-	w.print("def ")
+	w.printDef("def ", fd, indent)
+}
+
+func (w *writer) printDef(keyword string, fd *ast.FunctionDef, indent int32) {
+	w.printDecorators(fd.DecoratorList, indent)
+	w.print(keyword)
 	w.print(fd.Name)
-	w.print("(")
+	w.open("(", bracketDef)
 	if fd.Args != nil {
-		for i, arg := range fd.Args.Args {
-			w.printArg(arg, indent)
-			if i != len(fd.Args.Args)-1 {
-				w.print(", ")
-			}
-		}
-		if len(fd.Args.KwOnlyArgs) > 0 {
-			w.print(", *, ")
-			for i, arg := range fd.Args.KwOnlyArgs {
-				w.printArg(arg, indent)
-				if i != len(fd.Args.KwOnlyArgs)-1 {
-					w.print(", ")
-				}
-			}
-		}
+		w.printArguments(fd.Args, indent)
 	}
-	w.print(")")
+	w.close(")")
 	if fd.Returns != nil {
 		w.print(" -> ")
 		w.printNode(fd.Returns, indent)
 	}
+	if isEllipsis(fd.Body) {
+		w.print(": ...")
+		return
+	}
 	w.print(":\n")
 	for i, node := range fd.Body {
 		w.printIndent(indent + 1)
+		if i == 0 {
+			if e, ok := node.Node.(*ast.Node_Expr); ok {
+				if c, ok := e.Expr.Value.Node.(*ast.Node_Constant); ok && isStr(c.Constant) {
+					w.printDocstring(c.Constant.GetStr(), indent+1)
+					if len(fd.Body) > 1 {
+						w.print("\n")
+					}
+					continue
+				}
+			}
+		}
 		w.printNode(node, indent+1)
 		if i != len(fd.Body)-1 {
 			w.print("\n")
@@ -410,7 +749,8 @@ func (w *writer) printImport(imp *ast.Import, indent int32) {
 	for i, node := range imp.Names {
 		w.printNode(node, indent)
 		if i != len(imp.Names)-1 {
-			w.print(", ")
+			w.comma()
+			w.print(" ")
 		}
 	}
 	w.print("\n")
@@ -423,7 +763,8 @@ func (w *writer) printImportFrom(imp *ast.ImportFrom, indent int32) {
 	for i, node := range imp.Names {
 		w.printNode(node, indent)
 		if i != len(imp.Names)-1 {
-			w.print(", ")
+			w.comma()
+			w.print(" ")
 		}
 	}
 	w.print("\n")
@@ -443,9 +784,94 @@ func (w *writer) printIs(i *ast.Is, indent int32) {
 	w.print("is")
 }
 func (w *writer) printKeyword(k *ast.Keyword, indent int32) {
-	w.print(k.Arg)
-	w.print("=")
-	w.printNode(k.Value, indent)
+	if k.Arg == "" {
+		w.print("**")
+	} else {
+		w.print(k.Arg)
+		w.print("=")
+	}
+	w.printExpr(k.Value, indent, precTest)
+}
+
+func (w *writer) printLambda(l *ast.Lambda, indent int32) {
+	w.print("lambda")
+	if l.Args != nil {
+		m := w.mark()
+		w.print(" ")
+		w.printArguments(l.Args, indent)
+		if len(w.src) == m.src+1 {
+			w.reset(m)
+		}
+	}
+	w.print(": ")
+	w.printExpr(l.Body, indent, precTest)
+}
+
+func (w *writer) printList(l *ast.List, indent int32) {
+	w.open("[", bracketGroup)
+	split := len(l.Elts) > 3
+	eltIndent := indent
+	if split {
+		eltIndent += 1
+	}
+	for i, node := range l.Elts {
+		if split {
+			w.print("\n")
+			w.printIndent(eltIndent)
+		}
+		w.printNode(node, eltIndent)
+		if split {
+			w.comma()
+		} else if i != len(l.Elts)-1 {
+			w.comma()
+			w.print(" ")
+		}
+	}
+	if split {
+		w.print("\n")
+		w.printIndent(indent)
+	}
+	w.close("]")
+}
+
+func (w *writer) printListComp(l *ast.ListComp, indent int32) {
+	m := w.mark()
+	w.open("[", bracketGroup)
+	w.printComprehension(l, indent, " ")
+	w.close("]")
+	// A comprehension whose element spans several lines is split, with
+	// each clause on its own line
+	if bytes.IndexByte(w.src[m.src:], '\n') >= 0 {
+		w.reset(m)
+		sep := "\n" + strings.Repeat("    ", int(indent+1))
+		w.open("[", bracketGroup)
+		w.print(sep)
+		w.printComprehension(l, indent+1, sep)
+		w.print("\n")
+		w.printIndent(indent)
+		w.close("]")
+	}
+}
+
+// printComprehension prints the element and clauses of a comprehension,
+// separated by sep.
+func (w *writer) printComprehension(l *ast.ListComp, indent int32, sep string) {
+	w.printExpr(l.Elt, indent, precTest)
+	for _, gen := range l.Generators {
+		w.print(sep)
+		if gen.IsAsync != 0 {
+			w.print("async ")
+		}
+		w.print("for ")
+		w.printTarget(gen.Target, indent)
+		w.print(" in ")
+		w.printExpr(gen.Iter, indent, precTest+1)
+		for _, cond := range gen.Ifs {
+			w.print(sep)
+			w.print("if ")
+			w.printExpr(cond, indent, precTest+1)
+		}
+	}
 }
 
 func (w *writer) printModule(mod *ast.Module, indent int32) {
@@ -455,9 +881,16 @@ func (w *writer) printModule(mod *ast.Module, indent int32) {
 			_, isImport := mod.Body[i-1].Node.(*ast.Node_ImportGroup)
 			prevIsImport = isImport
 		}
-		_, isClassDef := node.Node.(*ast.Node_ClassDef)
+		isDef := false
+		switch node.Node.(type) {
+		case *ast.Node_ClassDef, *ast.Node_FunctionDef, *ast.Node_AsyncFunctionDef:
+			isDef = true
+		}
 		_, isAssign := node.Node.(*ast.Node_Assign)
-		if isClassDef || isAssign {
+		if _, ok := node.Node.(*ast.Node_AnnAssign); ok {
+			isAssign = true
+		}
+		if isDef || isAssign {
 			if prevIsImport {
 				w.print("\n")
 			} else {
@@ -465,7 +898,7 @@ func (w *writer) printModule(mod *ast.Module, indent int32) {
 			}
 		}
 		w.printNode(node, indent)
-		if isAssign {
+		if !bytes.HasSuffix(w.src, []byte("\n")) {
 			w.print("\n")
 		}
 	}
@@ -475,20 +908,132 @@ func (w *writer) printName(n *ast.Name, indent int32) {
 	w.print(n.Id)
 }
 
+func (w *writer) printRaise(r *ast.Raise, indent int32) {
+	w.print("raise")
+	if r.Exc == nil {
+		return
+	}
+	w.print(" ")
+	w.printExpr(r.Exc, indent, precTest)
+	if r.Cause != nil {
+		w.print(" from ")
+		w.printExpr(r.Cause, indent, precTest)
+	}
+}
+
 func (w *writer) printReturn(r *ast.Return, indent int32) {
-	w.print("return ")
-	w.printNode(r.Value, indent)
+	w.print("return")
+	if r.Value != nil {
+		w.print(" ")
+		w.printExpr(r.Value, indent, precTest)
+	}
 }
 
 func (w *writer) printSubscript(ss *ast.Subscript, indent int32) {
-	w.printName(ss.Value, indent)
-	w.print("[")
-	w.printNode(ss.Slice, indent)
-	w.print("]")
+	w.printNode(ss.Value, indent)
+	w.open("[", bracketGroup)
+	if t, ok := ss.Slice.Node.(*ast.Node_Tuple); ok {
+		// Tuples are printed without parentheses in subscripts, as in
+		// Annotated[int, "meta"]
+		w.printElts(t.Tuple.Elts, indent)
+	} else {
+		w.printNode(ss.Slice, indent)
+	}
+	w.close("]")
 
 }
 
+// printTarget prints the target of an assignment or a for loop, where
+// tuples are unpacked without parentheses.
+func (w *writer) printTarget(node *ast.Node, indent int32) {
+	if t, ok := node.Node.(*ast.Node_Tuple); ok && len(t.Tuple.Elts) > 1 {
+		w.printElts(t.Tuple.Elts, indent)
+		return
+	}
+	w.printNode(node, indent)
+}
+
+func (w *writer) printTry(t *ast.Try, indent int32) {
+	w.print("try:\n")
+	w.printBody(t.Body, indent)
+	for _, h := range t.Handlers {
+		w.print("\n")
+		w.printIndent(indent)
+		w.print("except")
+		if h.Type != nil {
+			w.print(" ")
+			w.printExpr(h.Type, indent, precTest)
+		}
+		if h.Name != "" {
+			w.print(" as ")
+			w.print(h.Name)
+		}
+		w.print(":\n")
+		w.printBody(h.Body, indent)
+	}
+	if len(t.OrElse) > 0 {
+		w.print("\n")
+		w.printIndent(indent)
+		w.print("else:\n")
+		w.printBody(t.OrElse, indent)
+	}
+	if len(t.FinalBody) > 0 {
+		w.print("\n")
+		w.printIndent(indent)
+		w.print("finally:\n")
+		w.printBody(t.FinalBody, indent)
+	}
+}
+
+func (w *writer) printTuple(t *ast.Tuple, indent int32) {
+	w.open("(", bracketTuple)
+	w.printElts(t.Elts, indent)
+	if len(t.Elts) == 1 {
+		w.comma()
+	}
+	w.close(")")
+}
+
+func (w *writer) printElts(elts []*ast.Node, indent int32) {
+	for i, node := range elts {
+		w.printExpr(node, indent, precTest)
+		if i != len(elts)-1 {
+			w.comma()
+			w.print(" ")
+		}
+	}
+}
+
+func (w *writer) printUnaryOp(u *ast.UnaryOp, indent int32) {
+	o, _ := op(u.Op)
+	w.print(o.text)
+	if o.prec == precNot {
+		w.print(" ")
+	}
+	w.printExpr(u.Operand, indent, o.prec)
+}
+
+func (w *writer) printWith(n *ast.With, indent int32) {
+	w.print("with ")
+	for i, item := range n.Items {
+		if i != 0 {
+			w.comma()
+			w.print(" ")
+		}
+		w.printExpr(item.ContextExpr, indent, precTest)
+		if item.OptionalVars != nil {
+			w.print(" as ")
+			w.printNode(item.OptionalVars, indent)
+		}
+	}
+	w.print(":\n")
+	w.printBody(n.Body, indent)
+}
+
 func (w *writer) printYield(n *ast.Yield, indent int32) {
-	w.print("yield ")
-	w.printNode(n.Value, indent)
+	w.print("yield")
+	if n.Value != nil {
+		w.print(" ")
+		w.printExpr(n.Value, indent, precAtom)
+	}
 }
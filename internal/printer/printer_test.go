@@ -11,6 +11,7 @@ import (
 
 type testcase struct {
 	Node     *ast.Node
+	Options  Options
 	Expected string
 }
 
@@ -104,7 +105,11 @@ func TestPrinter(t *testing.T) {
 										Annotation: &ast.Node{
 											Node: &ast.Node_Subscript{
 												Subscript: &ast.Subscript{
-													Value: &ast.Name{Id: "Optional"},
+													Value: &ast.Node{
+														Node: &ast.Node_Name{
+															Name: &ast.Name{Id: "Optional"},
+														},
+													},
 													Slice: &ast.Node{
 														Node: &ast.Node_Name{
 															Name: &ast.Name{Id: "int"},
@@ -188,10 +193,373 @@ class Foo:
 			},
 			Expected: `from pkg import foo, bar`,
 		},
+		"import-from-as": {
+			Node: &ast.Node{
+				Node: &ast.Node_ImportFrom{
+					ImportFrom: &ast.ImportFrom{
+						Module: "pkg",
+						Names: []*ast.Node{
+							{
+								Node: &ast.Node_Alias{
+									Alias: &ast.Alias{
+										Name:   "foo",
+										Asname: "bar",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: `from pkg import foo as bar`,
+		},
+		"list": {
+			Node: &ast.Node{
+				Node: &ast.Node_List{
+					List: &ast.List{
+						Elts: []*ast.Node{
+							{
+								Node: &ast.Node_Name{
+									Name: &ast.Name{Id: "foo"},
+								},
+							},
+							{
+								Node: &ast.Node_Name{
+									Name: &ast.Name{Id: "bar"},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: `[foo, bar]`,
+		},
+		"tuple": {
+			Node: &ast.Node{
+				Node: &ast.Node_Tuple{
+					Tuple: &ast.Tuple{
+						Elts: []*ast.Node{
+							{
+								Node: &ast.Node_Name{
+									Name: &ast.Name{Id: "foo"},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: `(foo,)`,
+		},
+		"subscript-tuple": {
+			Node: &ast.Node{
+				Node: &ast.Node_Subscript{
+					Subscript: &ast.Subscript{
+						Value: &ast.Node{
+							Node: &ast.Node_Name{
+								Name: &ast.Name{Id: "Annotated"},
+							},
+						},
+						Slice: &ast.Node{
+							Node: &ast.Node_Tuple{
+								Tuple: &ast.Tuple{
+									Elts: []*ast.Node{
+										{
+											Node: &ast.Node_Name{
+												Name: &ast.Name{Id: "int"},
+											},
+										},
+										{
+											Node: &ast.Node_Constant{
+												Constant: &ast.Constant{
+													Value: &ast.Constant_Str{Str: "meta"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: `Annotated[int, "meta"]`,
+		},
+		"string-double-quotes": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: `SELECT "name" FROM "authors"`},
+					},
+				},
+			},
+			Expected: `'SELECT "name" FROM "authors"'`,
+		},
+		"string-both-quotes": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: `it's "quoted"`},
+					},
+				},
+			},
+			Expected: `"it's \"quoted\""`,
+		},
+		"string-backslash": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: `a\b`},
+					},
+				},
+			},
+			Expected: `"a\\b"`,
+		},
+		"string-control": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: "tab\tbell\x07"},
+					},
+				},
+			},
+			Expected: `"tab\tbell\x07"`,
+		},
+		"string-raw": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: `a\b`},
+					},
+				},
+			},
+			Options:  Options{RawStrings: true},
+			Expected: `r"a\b"`,
+		},
+		"string-raw-trailing-backslash": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: `a\`},
+					},
+				},
+			},
+			Options:  Options{RawStrings: true},
+			Expected: `"a\\"`,
+		},
+		"string-triple-quotes": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: "SELECT '\"\"\"'\nFROM \"t\""},
+					},
+				},
+			},
+			Expected: `"""SELECT '\"""'
+FROM "t\""""`,
+		},
+		"string-multiline-backslash": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: "SELECT E'\\\\n'\r\n"},
+					},
+				},
+			},
+			Expected: `"""SELECT E'\\\\n'\r
+"""`,
+		},
+		"string-multiline-raw": {
+			Node: &ast.Node{
+				Node: &ast.Node_Constant{
+					Constant: &ast.Constant{
+						Value: &ast.Constant_Str{Str: "-- name: foo \\:one\nSELECT 1\n"},
+					},
+				},
+			},
+			Options: Options{RawStrings: true},
+			Expected: `r"""-- name: foo \:one
+SELECT 1
+"""`,
+		},
+		"function-ellipsis": {
+			Node: &ast.Node{
+				Node: &ast.Node_FunctionDef{
+					FunctionDef: &ast.FunctionDef{
+						Name: "foo",
+						Args: &ast.Arguments{},
+						Body: []*ast.Node{
+							{
+								Node: &ast.Node_Expr{
+									Expr: &ast.Expr{
+										Value: &ast.Node{
+											Node: &ast.Node_Constant{
+												Constant: &ast.Constant{
+													Value: &ast.Constant_Ellipsis{Ellipsis: true},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						Returns: &ast.Node{
+							Node: &ast.Node_Name{
+								Name: &ast.Name{Id: "int"},
+							},
+						},
+					},
+				},
+			},
+			Expected: `def foo() -> int: ...`,
+		},
+		"function-docstring": {
+			Node: &ast.Node{
+				Node: &ast.Node_FunctionDef{
+					FunctionDef: &ast.FunctionDef{
+						Name: "foo",
+						Args: &ast.Arguments{},
+						Body: []*ast.Node{
+							{
+								Node: &ast.Node_Expr{
+									Expr: &ast.Expr{
+										Value: &ast.Node{
+											Node: &ast.Node_Constant{
+												Constant: &ast.Constant{
+													Value: &ast.Constant_Str{Str: "Summary.\n\nDetails."},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Node: &ast.Node_Pass{},
+							},
+						},
+					},
+				},
+			},
+			Expected: `
+def foo():
+    """Summary.
+
+    Details.
+    """
+    pass
+`,
+		},
+		"function-defaults": {
+			Node: &ast.Node{
+				Node: &ast.Node_FunctionDef{
+					FunctionDef: &ast.FunctionDef{
+						Name: "foo",
+						DecoratorList: []*ast.Node{
+							{
+								Node: &ast.Node_Name{
+									Name: &ast.Name{Id: "staticmethod"},
+								},
+							},
+						},
+						Args: &ast.Arguments{
+							Args: []*ast.Arg{
+								{Arg: "a"},
+							},
+							Defaults: []*ast.Node{
+								{
+									Node: &ast.Node_Constant{
+										Constant: &ast.Constant{
+											Value: &ast.Constant_Int{Int: 1},
+										},
+									},
+								},
+							},
+							KwOnlyArgs: []*ast.Arg{
+								{
+									Arg: "b",
+									Annotation: &ast.Node{
+										Node: &ast.Node_Name{
+											Name: &ast.Name{Id: "bool"},
+										},
+									},
+								},
+							},
+							KwDefaults: []*ast.Node{
+								{
+									Node: &ast.Node_Constant{
+										Constant: &ast.Constant{
+											Value: &ast.Constant_Bool{Bool: false},
+										},
+									},
+								},
+							},
+						},
+						Body: []*ast.Node{
+							{
+								Node: &ast.Node_Pass{},
+							},
+						},
+					},
+				},
+			},
+			Expected: `
+@staticmethod
+def foo(a=1, *, b: bool = False):
+    pass
+`,
+		},
+		"if-elif-else": {
+			Node: &ast.Node{
+				Node: &ast.Node_If{
+					If: &ast.If{
+						Test: &ast.Node{
+							Node: &ast.Node_Name{
+								Name: &ast.Name{Id: "a"},
+							},
+						},
+						Body: []*ast.Node{
+							{
+								Node: &ast.Node_Pass{},
+							},
+						},
+						OrElse: []*ast.Node{
+							{
+								Node: &ast.Node_If{
+									If: &ast.If{
+										Test: &ast.Node{
+											Node: &ast.Node_Name{
+												Name: &ast.Name{Id: "b"},
+											},
+										},
+										Body: []*ast.Node{
+											{
+												Node: &ast.Node_Break{},
+											},
+										},
+										OrElse: []*ast.Node{
+											{
+												Node: &ast.Node_Continue{},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: `
+if a:
+    pass
+elif b:
+    break
+else:
+    continue
+`,
+		},
 	} {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			result := Print(tc.Node, Options{})
+			result := Print(tc.Node, tc.Options)
 			if diff := cmp.Diff(strings.TrimSpace(tc.Expected), strings.TrimSpace(string(result.Python))); diff != "" {
 				t.Errorf("print mismatch (-want +got):\n%s", diff)
 			}
@@ -0,0 +1,250 @@
+package printer
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// unparse parses the source read from stdin and compares its tokens to the
+// tokens of ast.unparse's output. Layout and trailing commas are ignored,
+// and strings are compared by value.
+const unparse = `
+import ast, io, sys, tokenize
+
+def tokens(src):
+    result = []
+    for tok in tokenize.generate_tokens(io.StringIO(src).readline):
+        if tok.type in (tokenize.NL, tokenize.COMMENT, tokenize.ENDMARKER):
+            continue
+        if tok.type in (tokenize.NEWLINE, tokenize.INDENT, tokenize.DEDENT):
+            result.append(tokenize.tok_name[tok.type])
+        elif tok.type == tokenize.STRING:
+            result.append(repr(ast.literal_eval(tok.string)))
+        else:
+            result.append(tok.string)
+    return [t for i, t in enumerate(result) if not (t == "," and result[i + 1] in ")]}")]
+
+src = sys.stdin.read()
+try:
+    tree = ast.parse(src)
+except SyntaxError as e:
+    if "--skip-syntax-error" in sys.argv:
+        print("skip")
+        sys.exit(0)
+    raise
+want = ast.unparse(tree)
+if tokens(src) != tokens(want):
+    print(want)
+    sys.exit(1)
+print("ok")
+`
+
+func TestRoundTrip(t *testing.T) {
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 not found")
+	}
+	a, b, c := poet.Name("a"), poet.Name("b"), poet.Name("c")
+	call := func(fn string, args ...*ast.Node) *ast.Node {
+		return poet.Node(&ast.Call{Func: poet.Name(fn), Args: args})
+	}
+	arg := func(name string) *ast.Arg {
+		return &ast.Arg{Arg: name}
+	}
+	for name, tc := range map[string]struct {
+		Node *ast.Node
+		// Skip is set for syntax not supported by every Python version
+		Skip bool
+	}{
+		"precedence": {
+			Node: poet.Node(&ast.Module{Body: []*ast.Node{
+				poet.Expr(poet.BinOp(a, &ast.Sub{}, poet.BinOp(b, &ast.Sub{}, c))),
+				poet.Expr(poet.BinOp(poet.BinOp(a, &ast.Sub{}, b), &ast.Sub{}, c)),
+				poet.Expr(poet.BinOp(poet.BinOp(a, &ast.Add{}, b), &ast.Mult{}, c)),
+				poet.Expr(poet.BinOp(a, &ast.Pow{}, poet.BinOp(b, &ast.Pow{}, c))),
+				poet.Expr(poet.BinOp(poet.BinOp(a, &ast.Pow{}, b), &ast.Pow{}, c)),
+				poet.Expr(poet.BinOp(poet.UnaryOp(&ast.USub{}, a), &ast.Pow{}, b)),
+				poet.Expr(poet.UnaryOp(&ast.USub{}, poet.BinOp(a, &ast.Pow{}, b))),
+				poet.Expr(poet.BinOp(a, &ast.BitOr{}, poet.BinOp(b, &ast.BitAnd{}, c))),
+				poet.Expr(poet.BinOp(poet.BinOp(a, &ast.BitOr{}, b), &ast.BitAnd{}, c)),
+				poet.Expr(poet.BoolOp(&ast.And{}, poet.BoolOp(&ast.Or{}, a, b), c)),
+				poet.Expr(poet.BoolOp(&ast.Or{}, a, poet.BoolOp(&ast.And{}, b, c))),
+				poet.Expr(poet.BoolOp(&ast.And{}, poet.UnaryOp(&ast.Not{}, a), poet.UnaryOp(&ast.Not{}, poet.BoolOp(&ast.Or{}, b, c)))),
+				poet.Expr(poet.Node(&ast.Compare{
+					Left:        poet.BinOp(a, &ast.Add{}, b),
+					Ops:         poet.Nodes(&ast.Lt{}, &ast.NotIn{}),
+					Comparators: []*ast.Node{c, poet.List(a, b)},
+				})),
+				poet.Expr(poet.Node(&ast.Compare{
+					Left:        poet.Node(&ast.Compare{Left: a, Ops: poet.Nodes(&ast.IsNot{}), Comparators: []*ast.Node{b}}),
+					Ops:         poet.Nodes(&ast.Eq{}),
+					Comparators: []*ast.Node{c},
+				})),
+				poet.Expr(poet.Attribute(poet.Await(call("f", a)), "b")),
+				poet.Expr(poet.Attribute(poet.BinOp(a, &ast.Add{}, b), "c")),
+				poet.Expr(call("f", poet.Starred(poet.BinOp(a, &ast.Add{}, b)), poet.UnaryOp(&ast.Invert{}, c))),
+			}}),
+		},
+		"expressions": {
+			Node: poet.Node(&ast.Module{Body: []*ast.Node{
+				poet.Node(&ast.Assign{
+					Targets: []*ast.Node{poet.Tuple(a, b), c},
+					Value:   poet.Tuple(poet.Constant(1), poet.Constant(true)),
+				}),
+				poet.Node(&ast.Assign{
+					Targets: []*ast.Node{a},
+					Value: poet.Node(&ast.IfExp{
+						Test:   b,
+						Body:   poet.Node(&ast.IfExp{Test: a, Body: b, OrElse: c}),
+						OrElse: poet.Node(&ast.IfExp{Test: a, Body: b, OrElse: c}),
+					}),
+				}),
+				poet.Node(&ast.Assign{
+					Targets: []*ast.Node{a},
+					Value: poet.Node(&ast.Lambda{
+						Args: &ast.Arguments{Args: []*ast.Arg{arg("x")}, Defaults: []*ast.Node{poet.Constant(1)}},
+						Body: poet.Node(&ast.Lambda{Body: poet.Name("x")}),
+					}),
+				}),
+				poet.Node(&ast.Assign{
+					Targets: []*ast.Node{a},
+					Value: poet.Node(&ast.ListComp{
+						Elt: poet.Tuple(poet.Name("x"), poet.Name("y")),
+						Generators: []*ast.Comprehension{
+							{
+								Target: poet.Tuple(poet.Name("x"), poet.Name("y")),
+								Iter:   poet.Node(&ast.IfExp{Test: a, Body: b, OrElse: c}),
+								Ifs:    []*ast.Node{poet.BoolOp(&ast.Or{}, poet.Name("x"), poet.Name("y"))},
+							},
+						},
+					}),
+				}),
+				poet.Node(&ast.Assign{
+					Targets: []*ast.Node{a},
+					Value: poet.Node(&ast.Call{
+						Func:     poet.Attribute(a, "b"),
+						Args:     []*ast.Node{poet.Starred(b)},
+						Keywords: []*ast.Keyword{{Arg: "key", Value: poet.Constant(nil)}, {Value: c}},
+					}),
+				}),
+			}}),
+		},
+		"statements": {
+			Node: poet.Node(&ast.Module{Body: []*ast.Node{
+				poet.Node(&ast.FunctionDef{
+					Name: "f",
+					DecoratorList: []*ast.Node{
+						poet.Attribute(poet.Name("functools"), "cache"),
+					},
+					Args: &ast.Arguments{
+						PosOnlyArgs: []*ast.Arg{arg("a")},
+						Args:        []*ast.Arg{arg("b"), {Arg: "c", Annotation: poet.Name("int")}},
+						Vararg:      arg("args"),
+						KwOnlyArgs:  []*ast.Arg{arg("d"), {Arg: "e", Annotation: poet.Name("str")}},
+						Kwarg:       arg("kwargs"),
+						Defaults:    []*ast.Node{poet.Constant(1)},
+						KwDefaults:  []*ast.Node{{}, poet.Constant("e")},
+					},
+					Body: []*ast.Node{
+						poet.Node(&ast.Global{Names: []string{"x", "y"}}),
+						poet.Node(&ast.Try{
+							Body: []*ast.Node{
+								poet.Node(&ast.With{
+									Items: []*ast.WithItem{
+										{ContextExpr: call("open", a), OptionalVars: poet.Name("fp")},
+										{ContextExpr: b},
+									},
+									Body: []*ast.Node{poet.Return(nil)},
+								}),
+							},
+							Handlers: []*ast.ExceptHandler{
+								{
+									Type: poet.Tuple(poet.Name("KeyError"), poet.Name("ValueError")),
+									Name: "err",
+									Body: []*ast.Node{
+										poet.Node(&ast.Raise{Exc: call("RuntimeError"), Cause: poet.Name("err")}),
+									},
+								},
+								{Body: []*ast.Node{poet.Raise(nil)}},
+							},
+							OrElse:    []*ast.Node{poet.Node(&ast.Pass{})},
+							FinalBody: []*ast.Node{poet.Expr(call("close"))},
+						}),
+					},
+				}),
+				poet.Node(&ast.AsyncFunctionDef{
+					Name:          "g",
+					DecoratorList: []*ast.Node{poet.Name("staticmethod")},
+					Args:          &ast.Arguments{Args: []*ast.Arg{arg("a")}},
+					Body: []*ast.Node{
+						poet.Node(&ast.AsyncWith{
+							Items: []*ast.WithItem{{ContextExpr: a, OptionalVars: poet.Tuple(b, c)}},
+							Body: []*ast.Node{
+								poet.Node(&ast.For{
+									Target: poet.Tuple(b, c),
+									Iter:   a,
+									Body: []*ast.Node{
+										poet.Node(&ast.If{
+											Test: poet.UnaryOp(&ast.Not{}, b),
+											Body: []*ast.Node{poet.Node(&ast.Continue{})},
+											OrElse: []*ast.Node{
+												poet.Node(&ast.If{
+													Test:   c,
+													Body:   []*ast.Node{poet.Node(&ast.Break{})},
+													OrElse: []*ast.Node{poet.Expr(poet.Yield(poet.BinOp(b, &ast.Add{}, c)))},
+												}),
+											},
+										}),
+									},
+								}),
+							},
+						}),
+					},
+				}),
+			}}),
+		},
+		"subscript-attribute": {
+			Node: poet.Node(&ast.Module{Body: []*ast.Node{
+				poet.Return(poet.Node(&ast.Subscript{
+					Value: poet.Attribute(poet.Name("row"), "_mapping"),
+					Slice: poet.Constant("id"),
+				})),
+			}}),
+		},
+		"type-alias": {
+			Node: poet.Node(&ast.Module{Body: []*ast.Node{
+				poet.Node(&ast.TypeAlias{
+					Name:  &ast.Name{Id: "Row"},
+					Value: poet.Node(&ast.Subscript{Value: poet.Name("tuple"), Slice: poet.Tuple(poet.Name("int"), poet.Name("str"))}),
+				}),
+			}}),
+			Skip: true,
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			args := []string{"-c", unparse}
+			if tc.Skip {
+				args = append(args, "--skip-syntax-error")
+			}
+			src := Print(tc.Node, Options{}).Python
+			cmd := exec.Command(python, args...)
+			cmd.Stdin = bytes.NewReader(src)
+			var stderr bytes.Buffer
+			cmd.Stderr = &stderr
+			out, err := cmd.Output()
+			switch strings.TrimSpace(string(out)) {
+			case "ok":
+			case "skip":
+				t.Skip("syntax not supported by python3")
+			default:
+				t.Errorf("printed source does not match ast.unparse: %v\n%s\nprinted:\n%s\nunparsed:\n%s", err, stderr.String(), src, out)
+			}
+		})
+	}
+}
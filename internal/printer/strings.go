@@ -0,0 +1,105 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pyString returns the Python literal for a string. Strings containing
+// newlines are triple-quoted. With raw, strings containing backslashes are
+// written as raw strings when they can be, so backslashes are not doubled.
+func pyString(s string, raw bool) string {
+	if strings.Contains(s, "\n") {
+		if raw && canTripleQuoteRaw(s) {
+			return `r"""` + s + `"""`
+		}
+		return `"""` + escapeTripleQuoted(s) + `"""`
+	}
+	// Single quotes are used if they avoid escaping double quotes
+	quote := '"'
+	if strings.ContainsRune(s, '"') && !strings.ContainsRune(s, '\'') {
+		quote = '\''
+	}
+	if raw && canRaw(s, quote) {
+		return "r" + string(quote) + s + string(quote)
+	}
+	return string(quote) + escape(s, quote) + string(quote)
+}
+
+func isControl(r rune) bool {
+	return r < 0x20 || r == 0x7f
+}
+
+func escapeRune(b *strings.Builder, r rune) {
+	switch r {
+	case '\\':
+		b.WriteString(`\\`)
+	case '\n':
+		b.WriteString(`\n`)
+	case '\r':
+		b.WriteString(`\r`)
+	case '\t':
+		b.WriteString(`\t`)
+	default:
+		if isControl(r) {
+			fmt.Fprintf(b, `\x%02x`, r)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+}
+
+func escape(s string, quote rune) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r == quote {
+			b.WriteRune('\\')
+		}
+		escapeRune(&b, r)
+	}
+	return b.String()
+}
+
+// escapeTripleQuoted escapes a string to be put between """. Newlines and
+// tabs are kept, and double quotes are only escaped when they would end the
+// string.
+func escapeTripleQuoted(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '"' && (i == len(s)-1 || strings.HasPrefix(s[i+1:], `""`)):
+			b.WriteString(`\"`)
+		case r == '\n' || r == '\t':
+			b.WriteRune(r)
+		default:
+			escapeRune(&b, r)
+		}
+	}
+	return b.String()
+}
+
+// A raw string can't end with a backslash, contain its quotes or contain
+// control characters, which would have to be escaped.
+func canRaw(s string, quote rune) bool {
+	if !strings.ContainsRune(s, '\\') || strings.HasSuffix(s, `\`) || strings.ContainsRune(s, quote) {
+		return false
+	}
+	for _, r := range s {
+		if isControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func canTripleQuoteRaw(s string) bool {
+	if !strings.ContainsRune(s, '\\') || strings.HasSuffix(s, `\`) || strings.HasSuffix(s, `"`) || strings.Contains(s, `"""`) {
+		return false
+	}
+	for _, r := range s {
+		if isControl(r) && r != '\n' && r != '\t' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,394 @@
+package printer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// The wrapper splits lines longer than Options.LineLength the way black and
+// ruff format do. It lays out the tokens recorded by the printer, so it
+// applies to every construct the printer knows about.
+
+type tokenKind int
+
+const (
+	tokenAtom tokenKind = iota
+	tokenOpen
+	tokenClose
+	tokenComma
+	tokenString
+	tokenComment
+)
+
+// bracketKind tells what an opening bracket encloses
+type bracketKind int
+
+const (
+	// bracketGroup is any other bracket, such as a list, a dict, a
+	// subscript or parentheses around an expression
+	bracketGroup bracketKind = iota
+	// bracketCall holds the arguments of a call or the bases of a class
+	bracketCall
+	// bracketDef holds the arguments of a function definition
+	bracketDef
+	bracketTuple
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// prefix is the whitespace before the token
+	prefix string
+	// bracket is the kind of an opening bracket
+	bracket bracketKind
+}
+
+type wrapper struct {
+	options Options
+}
+
+// wrap lays out the tokens of the printed source, followed by the trailing
+// whitespace.
+func wrap(toks []token, trailing string, options Options) []byte {
+	w := wrapper{options: options}
+	var out strings.Builder
+	depth := 0
+	start := 0
+	for i := 0; i <= len(toks); i++ {
+		// A logical line ends before a token that starts on a new line
+		// outside of brackets
+		if i == len(toks) || (i > start && depth == 0 && strings.Contains(toks[i].prefix, "\n")) {
+			line := toks[start:i]
+			prefix := line[0].prefix
+			indent := prefix[strings.LastIndex(prefix, "\n")+1:]
+			out.WriteString(prefix[:len(prefix)-len(indent)])
+			out.WriteString(strings.Join(w.formatLine(w.normalize(line), indent), "\n"))
+			start = i
+		}
+		if i == len(toks) {
+			break
+		}
+		switch toks[i].kind {
+		case tokenOpen:
+			depth++
+		case tokenClose:
+			depth--
+		}
+	}
+	out.WriteString(trailing)
+	return []byte(out.String())
+}
+
+// normalize joins a logical line that spans several lines and removes the
+// magic trailing commas if they are ignored.
+func (w *wrapper) normalize(line []token) []token {
+	out := make([]token, 0, len(line))
+	for i, t := range line {
+		if i == 0 {
+			t.prefix = ""
+		} else if strings.Contains(t.prefix, "\n") {
+			if t.kind == tokenClose || line[i-1].kind == tokenOpen {
+				t.prefix = ""
+			} else {
+				t.prefix = " "
+			}
+		}
+		if w.options.SkipMagicTrailingComma && isMagicComma(line, i) {
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// matching returns the index of the bracket closing the one at i, or -1
+func matching(line []token, i int) int {
+	depth := 0
+	for j := i; j < len(line); j++ {
+		switch line[j].kind {
+		case tokenOpen:
+			depth++
+		case tokenClose:
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+// isMagicComma reports whether the token at i is a trailing comma, which
+// keeps the brackets around it exploded. The comma of a one-tuple is not.
+func isMagicComma(line []token, i int) bool {
+	if line[i].kind != tokenComma || i+1 >= len(line) || line[i+1].kind != tokenClose {
+		return false
+	}
+	depth := 0
+	for j := i - 1; j >= 0; j-- {
+		switch line[j].kind {
+		case tokenClose:
+			depth++
+		case tokenOpen:
+			if depth == 0 {
+				return line[j].bracket != bracketTuple
+			}
+			depth--
+		case tokenComma:
+			if depth == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasMagicComma(line []token) bool {
+	for i := range line {
+		if isMagicComma(line, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func render(line []token) string {
+	var b strings.Builder
+	for i, t := range line {
+		if i > 0 {
+			b.WriteString(t.prefix)
+		}
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+func (w *wrapper) fits(indent, s string) bool {
+	return !strings.Contains(s, "\n") && len(indent)+utf8.RuneCountInString(s) <= w.options.LineLength
+}
+
+type bracketPair struct {
+	open, close int
+}
+
+// pairs returns the brackets of a line that are not nested in other brackets
+func pairs(line []token) []bracketPair {
+	var ps []bracketPair
+	for i := 0; i < len(line); i++ {
+		if line[i].kind != tokenOpen {
+			continue
+		}
+		j := matching(line, i)
+		if j < 0 {
+			break
+		}
+		ps = append(ps, bracketPair{i, j})
+		i = j
+	}
+	return ps
+}
+
+func (w *wrapper) formatLine(line []token, indent string) []string {
+	s := render(line)
+	if line[0].kind == tokenComment || (!hasMagicComma(line) && w.fits(indent, s)) {
+		return []string{indent + s}
+	}
+	if isImportFrom(line) {
+		return w.splitImport(line, indent)
+	}
+	var p *bracketPair
+	isDef := false
+	for _, t := range line {
+		if t.kind == tokenOpen {
+			isDef = t.bracket == bracketDef
+			break
+		}
+	}
+	if isDef {
+		p = w.leftPair(line)
+	} else {
+		p = w.rightPair(line, indent)
+	}
+	if p == nil {
+		return []string{indent + s}
+	}
+	// The only argument of a function definition is exploded as well, so
+	// that it gets a trailing comma.
+	explode := isDef && len(elements(line[p.open+1:p.close])) == 1
+	return w.split(line, *p, indent, explode)
+}
+
+// leftPair returns the first brackets of a line, which hold the arguments of
+// a function definition.
+func (w *wrapper) leftPair(line []token) *bracketPair {
+	for _, p := range pairs(line) {
+		if p.close > p.open+1 {
+			return &p
+		}
+	}
+	return nil
+}
+
+// rightPair returns the brackets to split a line at. The last brackets are
+// preferred, unless the line before them would still be too long and the
+// trailers after earlier brackets fit on the closing line.
+func (w *wrapper) rightPair(line []token, indent string) *bracketPair {
+	ps := pairs(line)
+	var last *bracketPair
+	var candidates []bracketPair
+	for i := len(ps) - 1; i >= 0; i-- {
+		p := ps[i]
+		if p.close == p.open+1 {
+			continue
+		}
+		if last == nil {
+			last = &ps[i]
+			if !hasMagicComma(line) {
+				candidates = append(candidates, p)
+			}
+		} else {
+			if !w.fits(indent, render(line[p.close:])) {
+				break
+			}
+			candidates = append(candidates, p)
+		}
+		if isMagicComma(line, p.close-1) {
+			break
+		}
+	}
+	for _, p := range candidates {
+		if w.fits(indent, render(line[:p.open+1])) {
+			return &p
+		}
+	}
+	return last
+}
+
+// split puts the content of the brackets on their own lines. The content is
+// kept on a single line if it fits, otherwise each element goes on its own
+// line, followed by a trailing comma.
+func (w *wrapper) split(line []token, p bracketPair, indent string, explode bool) []string {
+	head := line[:p.open+1]
+	body := line[p.open+1 : p.close]
+	tail := line[p.close:]
+
+	lines := w.formatLine(head, indent)
+	inner := indent + "    "
+	elts := elements(body)
+	magic := hasMagicComma(body) || isMagicComma(line, p.close-1)
+	switch {
+	case len(body) == 0:
+	case !explode && !magic && w.fits(inner, render(body)):
+		lines = append(lines, inner+render(body))
+	case len(elts) > 1 || explode:
+		for _, elt := range elts {
+			if elt[len(elt)-1].kind != tokenComma {
+				elt = append(elt[:len(elt):len(elt)], token{kind: tokenComma, text: ","})
+			}
+			lines = append(lines, w.formatLine(elt, inner)...)
+		}
+	default:
+		// Comprehensions are split before their for and if clauses
+		for _, clause := range clauses(body) {
+			lines = append(lines, w.formatLine(clause, inner)...)
+		}
+	}
+	tail = append([]token{}, tail...)
+	tail[0].prefix = ""
+	return append(lines, w.formatLine(tail, indent)...)
+}
+
+// elements splits the content of brackets at its top-level commas. Each
+// element keeps the comma following it.
+func elements(body []token) [][]token {
+	var elts [][]token
+	depth := 0
+	start := 0
+	for i, t := range body {
+		switch t.kind {
+		case tokenOpen:
+			depth++
+		case tokenClose:
+			depth--
+		case tokenComma:
+			if depth == 0 {
+				elts = append(elts, body[start:i+1])
+				start = i + 1
+			}
+		}
+	}
+	if start < len(body) {
+		elts = append(elts, body[start:])
+	}
+	for i := range elts {
+		elts[i] = append([]token{}, elts[i]...)
+		elts[i][0].prefix = ""
+	}
+	return elts
+}
+
+// clauses splits the content of a comprehension before its top-level for and
+// if clauses. The content of other brackets is returned as a single clause.
+func clauses(body []token) [][]token {
+	var cs [][]token
+	depth := 0
+	start := 0
+	inFor := false
+	for i, t := range body {
+		switch t.kind {
+		case tokenOpen:
+			depth++
+		case tokenClose:
+			depth--
+		case tokenAtom:
+			if depth != 0 || i == 0 {
+				continue
+			}
+			isFor := t.text == "for" && body[i-1].text != "async"
+			isAsyncFor := t.text == "async" && i+1 < len(body) && body[i+1].text == "for"
+			// An if before the first for is part of a conditional
+			// expression
+			if isFor || isAsyncFor || (t.text == "if" && inFor) {
+				cs = append(cs, body[start:i])
+				start = i
+			}
+			if isFor {
+				inFor = true
+			}
+		}
+	}
+	cs = append(cs, body[start:])
+	for i := range cs {
+		cs[i] = append([]token{}, cs[i]...)
+		cs[i][0].prefix = ""
+	}
+	return cs
+}
+
+func isImportFrom(line []token) bool {
+	if line[0].text != "from" {
+		return false
+	}
+	for _, t := range line {
+		if t.kind == tokenOpen {
+			return false
+		}
+	}
+	return true
+}
+
+// splitImport wraps the names of a long import in parentheses, with one name
+// per line.
+func (w *wrapper) splitImport(line []token, indent string) []string {
+	for i, t := range line {
+		if t.kind != tokenAtom || t.text != "import" || i == len(line)-1 {
+			continue
+		}
+		wrapped := append([]token{}, line[:i+1]...)
+		wrapped = append(wrapped, token{kind: tokenOpen, text: "(", prefix: " "})
+		wrapped = append(wrapped, line[i+1:]...)
+		wrapped = append(wrapped, token{kind: tokenClose, text: ")"})
+		return w.split(wrapped, bracketPair{i + 1, len(wrapped) - 1}, indent, true)
+	}
+	return []string{indent + render(line)}
+}
@@ -0,0 +1,251 @@
+package printer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+func call(fn *ast.Node, args ...*ast.Node) *ast.Call {
+	return &ast.Call{Func: fn, Args: args}
+}
+
+func subscript(name string, slice *ast.Node) *ast.Node {
+	return poet.Node(&ast.Subscript{Value: poet.Name(name), Slice: slice})
+}
+
+func assign(target string, value *ast.Node) *ast.Node {
+	return poet.Node(&ast.Assign{Targets: []*ast.Node{poet.Name(target)}, Value: value})
+}
+
+// authorCall returns Author(id=row[0], name=row[1]), which the printer
+// splits with one keyword per line
+func authorCall(fn *ast.Node) *ast.Node {
+	c := call(fn)
+	c.Keywords = []*ast.Keyword{
+		{Arg: "id", Value: subscript("row", poet.Constant(0))},
+		{Arg: "name", Value: subscript("row", poet.Constant(1))},
+	}
+	return poet.Node(c)
+}
+
+func executeFirst() *ast.Node {
+	dict := &ast.Dict{
+		Keys:   []*ast.Node{poet.Constant("p1"), poet.Constant("p2")},
+		Values: []*ast.Node{poet.Name("a"), poet.Name("b")},
+	}
+	execute := call(poet.Attribute(poet.Name("conn"), "execute"),
+		poet.Node(call(poet.Name("text"), poet.Name("QUERY"))),
+		&ast.Node{Node: &ast.Node_Dict{Dict: dict}},
+	)
+	return assign("row", poet.Node(call(poet.Attribute(poet.Node(execute), "first"))))
+}
+
+func kwArgsDef(async bool, returns *ast.Node) *ast.Node {
+	fd := &ast.FunctionDef{
+		Name: "foo",
+		Args: &ast.Arguments{
+			Args: []*ast.Arg{{Arg: "self"}},
+			KwOnlyArgs: []*ast.Arg{
+				{Arg: "a", Annotation: poet.Name("int")},
+				{Arg: "b", Annotation: poet.Name("str")},
+			},
+		},
+		Returns: returns,
+		Body:    []*ast.Node{poet.Node(&ast.Pass{})},
+	}
+	if async {
+		return poet.Node(&ast.AsyncFunctionDef{Name: fd.Name, Args: fd.Args, Returns: fd.Returns, Body: fd.Body})
+	}
+	return poet.Node(fd)
+}
+
+func comprehension(elt *ast.Node, ifs ...*ast.Node) *ast.Node {
+	return poet.Return(poet.Node(&ast.ListComp{
+		Elt: elt,
+		Generators: []*ast.Comprehension{{
+			Target: poet.Name("row"),
+			Iter:   poet.Name("result"),
+			Ifs:    ifs,
+		}},
+	}))
+}
+
+func TestWrap(t *testing.T) {
+	for name, tc := range map[string]testcase{
+		"short": {
+			Options: Options{LineLength: 40},
+			Node: poet.Node(&ast.FunctionDef{
+				Name:    "foo",
+				Args:    &ast.Arguments{Args: []*ast.Arg{{Arg: "a", Annotation: poet.Name("int")}}},
+				Returns: poet.Name("int"),
+				Body: []*ast.Node{
+					poet.Return(poet.Node(call(poet.Name("bar"), poet.Name("a"), poet.Constant(1)))),
+				},
+			}),
+			Expected: `
+def foo(a: int) -> int:
+    return bar(a, 1)
+`,
+		},
+		"def": {
+			Options: Options{LineLength: 40},
+			Node:    kwArgsDef(false, subscript("Optional", poet.Name("int"))),
+			Expected: `
+def foo(
+    self, *, a: int, b: str
+) -> Optional[int]:
+    pass
+`,
+		},
+		"def-explode": {
+			Options: Options{LineLength: 25},
+			Node:    kwArgsDef(true, poet.Constant(nil)),
+			Expected: `
+async def foo(
+    self,
+    *,
+    a: int,
+    b: str,
+) -> None:
+    pass
+`,
+		},
+		"def-single-argument": {
+			Options: Options{LineLength: 30},
+			Node: poet.Node(&ast.FunctionDef{
+				Name:    "list_authors",
+				Args:    &ast.Arguments{Args: []*ast.Arg{{Arg: "self"}}},
+				Returns: subscript("Iterator", poet.Name("Author")),
+				Body:    []*ast.Node{poet.Expr(poet.Ellipsis())},
+			}),
+			Expected: `
+def list_authors(
+    self,
+) -> Iterator[Author]: ...
+`,
+		},
+		"call": {
+			Options: Options{LineLength: 50},
+			Node:    executeFirst(),
+			Expected: `
+row = conn.execute(
+    text(QUERY), {"p1": a, "p2": b}
+).first()
+`,
+		},
+		"call-explode": {
+			Options: Options{LineLength: 30},
+			Node:    executeFirst(),
+			Expected: `
+row = conn.execute(
+    text(QUERY),
+    {"p1": a, "p2": b},
+).first()
+`,
+		},
+		"magic-trailing-comma": {
+			Options: Options{LineLength: 88},
+			Node:    poet.Return(authorCall(poet.Name("Author"))),
+			Expected: `
+return Author(
+    id=row[0],
+    name=row[1],
+)
+`,
+		},
+		"skip-magic-trailing-comma": {
+			Options: Options{LineLength: 88, SkipMagicTrailingComma: true},
+			Node:    poet.Return(authorCall(poet.Name("Author"))),
+			Expected: `
+return Author(id=row[0], name=row[1])
+`,
+		},
+		"one-tuple": {
+			Options: Options{LineLength: 88, SkipMagicTrailingComma: true},
+			Node:    assign("x", poet.Tuple(poet.Name("a"))),
+			Expected: `
+x = (a,)
+`,
+		},
+		"comprehension": {
+			Options: Options{LineLength: 44},
+			Node: comprehension(
+				poet.Node(call(poet.Attribute(poet.Name("models"), "Author"),
+					subscript("row", poet.Constant(0)),
+					subscript("row", poet.Constant(1)),
+				)),
+				poet.Name("row"),
+			),
+			Expected: `
+return [
+    models.Author(row[0], row[1])
+    for row in result
+    if row
+]
+`,
+		},
+		"comprehension-magic-trailing-comma": {
+			Options: Options{LineLength: 88},
+			Node:    comprehension(authorCall(poet.Attribute(poet.Name("models"), "Author"))),
+			Expected: `
+return [
+    models.Author(
+        id=row[0],
+        name=row[1],
+    )
+    for row in result
+]
+`,
+		},
+		"import": {
+			Options: Options{LineLength: 40},
+			Node: poet.Node(&ast.ImportFrom{
+				Module: "typing",
+				Names: []*ast.Node{
+					poet.Alias("AsyncIterator"),
+					poet.Alias("Iterator"),
+					poet.Alias("Optional"),
+				},
+			}),
+			Expected: `
+from typing import (
+    AsyncIterator,
+    Iterator,
+    Optional,
+)
+`,
+		},
+		"string": {
+			Options: Options{LineLength: 20},
+			Node:    assign("QUERY", poet.Constant("SELECT (a, b)\nFROM foo\n")),
+			Expected: `
+QUERY = """SELECT (a, b)
+FROM foo
+"""
+`,
+		},
+		"string-brackets": {
+			Options: Options{LineLength: 20},
+			Node:    assign("x", poet.Node(call(poet.Name("foo"), poet.Constant("a # b ("), poet.Name("bar")))),
+			Expected: `
+x = foo(
+    "a # b (", bar
+)
+`,
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			result := Print(tc.Node, tc.Options)
+			expected := strings.TrimPrefix(tc.Expected, "\n")
+			if diff := cmp.Diff(strings.TrimSuffix(expected, "\n"), strings.TrimSuffix(string(result.Python), "\n")); diff != "" {
+				t.Errorf("wrap mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package python
+
+import (
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+// Values of the row_access option
+const (
+	rowAccessIndex = "index"
+	rowAccessName  = "name"
+)
+
+func validRowAccess(access string) bool {
+	switch access {
+	case "", rowAccessIndex, rowAccessName:
+		return true
+	}
+	return false
+}
+
+// resultColumnNames returns the labels of the columns of a query result, used
+// to read the fields of a row by name. Columns that can't be looked up by
+// name, because they have no name or share it with another column, are left
+// empty and read by position.
+func resultColumnNames(columns []*plugin.Column) []string {
+	count := map[string]int{}
+	for _, c := range columns {
+		count[c.Name]++
+	}
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		if c.Name != "" && count[c.Name] == 1 {
+			names[i] = c.Name
+		}
+	}
+	return names
+}
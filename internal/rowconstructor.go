@@ -0,0 +1,82 @@
+package python
+
+import (
+	"fmt"
+
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// Values of the row_constructor option
+const (
+	rowConstructorInit           = "init"
+	rowConstructorModelConstruct = "model_construct"
+	rowConstructorFromRow        = "from_row"
+)
+
+const fromRowMethod = "from_row"
+
+func checkRowConstructor(conf Config) error {
+	switch conf.RowConstructor {
+	case "", rowConstructorInit, rowConstructorFromRow:
+		return nil
+	case rowConstructorModelConstruct:
+		if !conf.EmitPydanticModels {
+			return fmt.Errorf("row_constructor %s requires emit_pydantic_models", rowConstructorModelConstruct)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid row_constructor: %q", conf.RowConstructor)
+}
+
+// skipsValidation reports whether rows are built without running the
+// validation of pydantic models
+func skipsValidation(conf Config) bool {
+	switch conf.RowConstructor {
+	case rowConstructorModelConstruct, rowConstructorFromRow:
+		return conf.EmitPydanticModels
+	}
+	return false
+}
+
+// callsFromRow reports whether the rows of v are built by the from_row
+// classmethod of its class, which reads the fields by position in the order
+// they are declared.
+func (v QueryValue) callsFromRow() bool {
+	return v.FromRow && v.Positions == nil && v.Columns == nil
+}
+
+// returnsStruct reports whether the rows of a query are built with the
+// from_row classmethod of the class s, which is only emitted then
+func returnsStruct(conf Config, queries []Query, s *Struct) bool {
+	if conf.RowConstructor != rowConstructorFromRow {
+		return false
+	}
+	for _, q := range queries {
+		if q.Ret.Struct != nil && q.Ret.Struct.Name == s.Name && q.Ret.Struct.Module == s.Module && q.Ret.callsFromRow() {
+			return true
+		}
+	}
+	return false
+}
+
+// fromRowFunctionDef returns the classmethod building an instance of the
+// class s from a result row
+func fromRowFunctionDef(conf Config, s *Struct) *pyast.Node {
+	v := QueryValue{Struct: s, ModelConstruct: skipsValidation(conf)}
+	var body []*pyast.Node
+	if conf.EmitDocstrings {
+		body = append(body, docNode("Create an instance from a result row, reading the fields by position."))
+	}
+	body = append(body, poet.Return(v.constructorNode(poet.Name("cls"), "row")))
+	return poet.Node(&pyast.FunctionDef{
+		Name:          fromRowMethod,
+		DecoratorList: []*pyast.Node{poet.Name("classmethod")},
+		Args: &pyast.Arguments{Args: []*pyast.Arg{
+			{Arg: "cls"},
+			{Arg: "row", Annotation: typeRefNode("sqlalchemy", "engine", "Row")},
+		}},
+		Returns: poet.Constant(s.Name),
+		Body:    body,
+	})
+}
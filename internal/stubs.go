@@ -0,0 +1,115 @@
+package python
+
+import (
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// stubTree returns the type stub of a generated module: module constants are
+// reduced to their type and functions to their signature.
+func stubTree(node *pyast.Node) *pyast.Node {
+	mod := node.GetModule()
+	stub := &pyast.Module{}
+	for _, n := range mod.Body {
+		if a := n.GetAssign(); a != nil && len(a.Targets) == 1 && a.Value.GetConstant().GetStr() != "" {
+			n = poet.Node(&pyast.AnnAssign{
+				Target:     a.Targets[0].GetName(),
+				Annotation: poet.Name("str"),
+			})
+		}
+		stub.Body = append(stub.Body, stubNode(n))
+	}
+	return poet.Node(stub)
+}
+
+func stubNode(node *pyast.Node) *pyast.Node {
+	switch n := node.Node.(type) {
+
+	case *pyast.Node_ClassDef:
+		def := &pyast.ClassDef{
+			Name:          n.ClassDef.Name,
+			Bases:         n.ClassDef.Bases,
+			Keywords:      n.ClassDef.Keywords,
+			DecoratorList: n.ClassDef.DecoratorList,
+		}
+		for _, b := range n.ClassDef.Body {
+			def.Body = append(def.Body, stubNode(b))
+		}
+		return poet.Node(def)
+
+	case *pyast.Node_FunctionDef:
+		return poet.Node(&pyast.FunctionDef{
+			Name:          n.FunctionDef.Name,
+			Args:          n.FunctionDef.Args,
+			Returns:       n.FunctionDef.Returns,
+			Body:          stubBody(n.FunctionDef.Body),
+			DecoratorList: n.FunctionDef.DecoratorList,
+		})
+
+	case *pyast.Node_AsyncFunctionDef:
+		// An async generator is declared as a regular function returning an
+		// AsyncIterator, as awaiting it would be an error.
+		if containsYield(n.AsyncFunctionDef.Body) {
+			return poet.Node(&pyast.FunctionDef{
+				Name:          n.AsyncFunctionDef.Name,
+				Args:          n.AsyncFunctionDef.Args,
+				Returns:       n.AsyncFunctionDef.Returns,
+				Body:          stubBody(n.AsyncFunctionDef.Body),
+				DecoratorList: n.AsyncFunctionDef.DecoratorList,
+			})
+		}
+		return poet.Node(&pyast.AsyncFunctionDef{
+			Name:          n.AsyncFunctionDef.Name,
+			Args:          n.AsyncFunctionDef.Args,
+			Returns:       n.AsyncFunctionDef.Returns,
+			Body:          stubBody(n.AsyncFunctionDef.Body),
+			DecoratorList: n.AsyncFunctionDef.DecoratorList,
+		})
+
+	default:
+		return node
+	}
+}
+
+// stubBody keeps the docstring of a function, if any
+func stubBody(body []*pyast.Node) []*pyast.Node {
+	var stub []*pyast.Node
+	if len(body) > 0 && body[0].GetExpr().GetValue().GetConstant().GetStr() != "" {
+		stub = append(stub, body[0])
+	}
+	return append(stub, poet.Expr(poet.Ellipsis()))
+}
+
+func containsYield(body []*pyast.Node) bool {
+	for _, node := range body {
+		switch n := node.Node.(type) {
+		case *pyast.Node_Yield:
+			return true
+		case *pyast.Node_Expr:
+			if containsYield([]*pyast.Node{n.Expr.Value}) {
+				return true
+			}
+		case *pyast.Node_For:
+			if containsYield(n.For.Body) {
+				return true
+			}
+		case *pyast.Node_AsyncFor:
+			if containsYield(n.AsyncFor.Body) {
+				return true
+			}
+		case *pyast.Node_With:
+			if containsYield(n.With.Body) {
+				return true
+			}
+		case *pyast.Node_AsyncWith:
+			if containsYield(n.AsyncWith.Body) {
+				return true
+			}
+		case *pyast.Node_If:
+			if containsYield(n.If.Body) || containsYield(n.If.OrElse) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package python
+
+import (
+	pyast "github.com/sqlc-dev/sqlc-gen-python/internal/ast"
+	"github.com/sqlc-dev/sqlc-gen-python/internal/poet"
+)
+
+// The transaction helpers of the queriers
+const (
+	beginMethod       = "begin"
+	beginNestedMethod = "begin_nested"
+)
+
+const beginDocstring = `Run queries in a transaction, which is committed when the block exits
+and rolled back if it raises.
+
+Raises sqlalchemy.exc.InvalidRequestError if the connection is already in
+a transaction, including one begun implicitly by a previous query. Use
+begin_nested() to run queries in a savepoint of that transaction instead.`
+
+const beginNestedDocstring = `Run queries in a savepoint, which is released when the block exits and
+rolled back if it raises.
+
+The savepoint is nested in the transaction of the connection, which is
+begun if there is none, and which must still be committed by the caller.`
+
+func connCallNode(method string) *pyast.Node {
+	return poet.Node(&pyast.Call{
+		Func: poet.Attribute(selfConn(), method),
+	})
+}
+
+// transactionNodes returns the statements of the transaction helper method,
+// which begins a transaction, or a savepoint with begin_nested, and yields a
+// querier running its queries in it.
+func transactionNodes(conf Config, method string, async bool) []*pyast.Node {
+	// type(self) keeps the class of combined queriers
+	querier := func(conn *pyast.Node) *pyast.Node {
+		args := []*pyast.Node{conn}
+		if conf.EmitQueryHooks {
+			args = append(args, poet.Attribute(poet.Name("self"), "_hooks"))
+		}
+		return poet.Node(&pyast.Call{
+			Func: poet.Node(&pyast.Call{
+				Func: poet.Name("type"),
+				Args: []*pyast.Node{poet.Name("self")},
+			}),
+			Args: args,
+		})
+	}
+	with := func(items []*pyast.WithItem, body []*pyast.Node) *pyast.Node {
+		if async {
+			return poet.Node(&pyast.AsyncWith{Items: items, Body: body})
+		}
+		return poet.Node(&pyast.With{Items: items, Body: body})
+	}
+	var nodes []*pyast.Node
+	if conf.EmitDocstrings {
+		doc := beginDocstring
+		if method == beginNestedMethod {
+			doc = beginNestedDocstring
+		}
+		nodes = append(nodes, poet.Expr(poet.Constant(doc)))
+	}
+	begin := []*pyast.Node{
+		with([]*pyast.WithItem{{ContextExpr: connCallNode(method)}}, []*pyast.Node{
+			poet.Expr(poet.Yield(querier(selfConn()))),
+		}),
+	}
+	if !conf.EmitEngineConstructors {
+		return append(nodes, begin...)
+	}
+	// A querier created from an engine checks out a connection for the
+	// whole transaction, and has no transaction to nest a savepoint in
+	_, engine, _ := connSources(async)
+	fromEngine := []*pyast.Node{
+		with([]*pyast.WithItem{{
+			ContextExpr:  connCallNode(beginMethod),
+			OptionalVars: poet.Name(connVar),
+		}}, []*pyast.Node{
+			poet.Expr(poet.Yield(querier(poet.Name(connVar)))),
+		}),
+	}
+	if method == beginNestedMethod {
+		fromEngine = []*pyast.Node{poet.Raise(poet.Node(&pyast.Call{
+			Func: poet.Name("TypeError"),
+			Args: []*pyast.Node{poet.Constant(beginNestedMethod + "() needs a querier created from a connection or session")},
+		}))}
+	}
+	return append(nodes, poet.Node(&pyast.If{
+		Test:   isInstanceNode(selfConn(), engine),
+		Body:   fromEngine,
+		OrElse: begin,
+	}))
+}
+
+// transactionFunctionDefs returns the transaction helpers of the querier
+// class cls, begin and begin_nested
+func transactionFunctionDefs(conf Config, cls string, async bool) []*pyast.Node {
+	var defs []*pyast.Node
+	for _, method := range []string{beginMethod, beginNestedMethod} {
+		args := &pyast.Arguments{
+			Args: []*pyast.Arg{{Arg: "self"}},
+		}
+		if async {
+			defs = append(defs, poet.Node(&pyast.AsyncFunctionDef{
+				Name:          method,
+				DecoratorList: []*pyast.Node{typeRefNode("contextlib", "asynccontextmanager")},
+				Args:          args,
+				Returns:       subscriptNode("AsyncIterator", poet.Constant(cls)),
+				Body:          transactionNodes(conf, method, true),
+			}))
+			continue
+		}
+		defs = append(defs, poet.Node(&pyast.FunctionDef{
+			Name:          method,
+			DecoratorList: []*pyast.Node{typeRefNode("contextlib", "contextmanager")},
+			Args:          args,
+			Returns:       subscriptNode("Iterator", poet.Constant(cls)),
+			Body:          transactionNodes(conf, method, false),
+		}))
+	}
+	return defs
+}
+
+// transactionImports adds the imports of the transaction helpers
+func transactionImports(conf Config, std map[string]importSpec) {
+	if !conf.EmitTransactionHelpers {
+		return
+	}
+	std["contextlib"] = importSpec{Module: "contextlib"}
+	if conf.EmitSyncQuerier {
+		std["typing.Iterator"] = importSpec{Module: "typing", Name: "Iterator"}
+	}
+	if conf.EmitAsyncQuerier {
+		std["typing.AsyncIterator"] = importSpec{Module: "typing", Name: "AsyncIterator"}
+	}
+}